@@ -17,16 +17,59 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Expose defines attributes to expose the service
 type Expose struct {
 	Service Service `json:"service,omitempty"`
 	Ingress Ingress `json:"ingress,omitempty"`
+	// GatewayRoute exposes the service with Gateway API routes attached to existing Gateways
+	GatewayRoute GatewayRoute `json:"gatewayRoute,omitempty"`
+}
+
+// TLS defines the certificate used to serve the service over TLS
+// +kubebuilder:validation:XValidation:rule="!(has(self.secretName) && has(self.certManager))",message="secretName and certManager are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.enabled) && self.enabled) || has(self.secretName) || has(self.certManager)",message="secretName or certManager is required when tls is enabled"
+type TLS struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// SecretName is an existing kubernetes.io/tls secret with the server certificate
+	SecretName string `json:"secretName,omitempty"`
+	// CertManager requests the server certificate from a cert-manager issuer
+	CertManager *CertManagerCertificate `json:"certManager,omitempty"`
+}
+
+// CertManagerCertificate defines attributes to request a certificate from cert-manager
+type CertManagerCertificate struct {
+	IssuerRef CertManagerIssuerReference `json:"issuerRef"`
+	// DNSNames are added to the in-cluster service names and the ingress hosts of the certificate
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// Duration is the requested lifetime of the certificate
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// RenewBefore is how long before expiry the certificate is renewed
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// CertManagerIssuerReference identifies the cert-manager issuer signing the certificate
+type CertManagerIssuerReference struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default:=Issuer
+	Kind string `json:"kind,omitempty"`
 }
 
 // Service defines attributes to create a service
@@ -61,6 +104,83 @@ type Autoscaling struct {
 	Annotations map[string]string           `json:"annotations,omitempty"`
 }
 
+// AutoscalingPreset defines a NIM inference metric to scale on
+type AutoscalingPreset struct {
+	// +kubebuilder:validation:Enum=queueDepth;kvCacheUtilization;timeToFirstToken
+	Name AutoscalingPresetName `json:"name"`
+	// Target is the average value of the metric per pod, defaults to 10 waiting requests, 0.75 cache usage or 2 seconds to first token
+	Target *resource.Quantity `json:"target,omitempty"`
+}
+
+// AutoscalingPresetName is the name of a NIM inference metric preset
+type AutoscalingPresetName string
+
+const (
+	// AutoscalingPresetQueueDepth scales on the number of requests waiting per pod
+	AutoscalingPresetQueueDepth AutoscalingPresetName = "queueDepth"
+	// AutoscalingPresetKVCacheUtilization scales on the KV-cache utilization per pod
+	AutoscalingPresetKVCacheUtilization AutoscalingPresetName = "kvCacheUtilization"
+	// AutoscalingPresetTimeToFirstToken scales on the average time to first token per pod
+	AutoscalingPresetTimeToFirstToken AutoscalingPresetName = "timeToFirstToken"
+)
+
+// PrometheusAdapter defines the prometheus-adapter configuration serving the metrics of the autoscaling presets
+// +kubebuilder:validation:XValidation:rule="!(has(self.enabled) && self.enabled) || (has(self.configMap) && has(self.namespace))",message="configMap and namespace are required when prometheusAdapter is enabled"
+type PrometheusAdapter struct {
+	// Enabled adds the rules of the preset metrics to the prometheus-adapter configuration, keeping its other rules.
+	// prometheus-adapter loads its configuration at startup and must be restarted to serve newly added rules
+	Enabled *bool `json:"enabled,omitempty"`
+	// ConfigMap is the name of the ConfigMap holding the prometheus-adapter configuration
+	ConfigMap string `json:"configMap,omitempty"`
+	// Namespace of the prometheus-adapter configuration ConfigMap
+	Namespace string `json:"namespace,omitempty"`
+	// Key of the configuration file in the ConfigMap
+	// +kubebuilder:default:=config.yaml
+	Key string `json:"key,omitempty"`
+}
+
+// KEDASpec defines the parameters required to setup a KEDA ScaledObject
+type KEDASpec struct {
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// PollingInterval is the interval in seconds to check each trigger
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+	// CooldownPeriod is the period in seconds to wait after the last active trigger before scaling to minReplicas
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+	// PrometheusURL is the address of the Prometheus server scraping the NIM metrics
+	PrometheusURL string `json:"prometheusURL"`
+	// +kubebuilder:validation:MinItems=1
+	Triggers []KEDATrigger `json:"triggers"`
+	// Behavior configures the scaling behavior of the HPA managed by KEDA
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// KEDATrigger defines a Prometheus trigger of the KEDA ScaledObject
+// +kubebuilder:validation:XValidation:rule="self.metric != 'custom' || (has(self.query) && has(self.threshold))", message="query and threshold are required for custom metrics"
+type KEDATrigger struct {
+	// Metric selects a query template for the NIM metric, or custom to use the given query
+	// +kubebuilder:validation:Enum=num_requests_waiting;gpu_cache_usage_perc;custom
+	Metric KEDAMetric `json:"metric"`
+	// Query overrides the query template of the metric
+	Query string `json:"query,omitempty"`
+	// Threshold is the target value of the metric, defaults to 10 waiting requests or 0.75 cache usage
+	Threshold string `json:"threshold,omitempty"`
+}
+
+// KEDAMetric is a NIM metric to scale on
+type KEDAMetric string
+
+const (
+	// KEDAMetricRequestsWaiting scales on the number of requests waiting in the NIM queue
+	KEDAMetricRequestsWaiting KEDAMetric = "num_requests_waiting"
+	// KEDAMetricGPUCacheUsage scales on the KV-cache utilization of the NIM pods
+	KEDAMetricGPUCacheUsage KEDAMetric = "gpu_cache_usage_perc"
+	// KEDAMetricCustom scales on a custom query
+	KEDAMetricCustom KEDAMetric = "custom"
+)
+
 // HorizontalPodAutoscalerSpec defines the parameters required to setup HPA
 type HorizontalPodAutoscalerSpec struct {
 	MinReplicas *int32                                         `json:"minReplicas,omitempty"`
@@ -69,6 +189,25 @@ type HorizontalPodAutoscalerSpec struct {
 	Behavior    *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty" `
 }
 
+// DRAResources defines GPUs allocated with Dynamic Resource Allocation instead of extended resources
+// +kubebuilder:validation:XValidation:rule="!has(self.resourceClaimTemplateName) || (!has(self.count) && !has(self.productName))",message="count and productName only apply to generated resource claim templates"
+type DRAResources struct {
+	// ResourceClaimTemplateName references an existing ResourceClaimTemplate, a template is generated when not set
+	ResourceClaimTemplateName string `json:"resourceClaimTemplateName,omitempty"`
+	// DeviceClassName is the DeviceClass of the GPUs requested by the generated template
+	// +kubebuilder:default:=gpu.nvidia.com
+	DeviceClassName string `json:"deviceClassName,omitempty"`
+	// Count is the number of GPUs requested by the generated template, defaults to the tensor parallelism of the model
+	// +kubebuilder:validation:Minimum=1
+	Count *int64 `json:"count,omitempty"`
+	// ProductName restricts the generated template to GPUs whose product name contains the words of the given value,
+	// e.g. A10 does not match the NVIDIA A100 GPUs, defaults to the gpu of the model profile
+	ProductName string `json:"productName,omitempty"`
+}
+
+// DRAClaimName is the name of the GPU resource claim in NIM pods
+const DRAClaimName = "gpu"
+
 // Image defines image attributes
 type Image struct {
 	Repository  string   `json:"repository,omitempty"`
@@ -85,6 +224,304 @@ type Ingress struct {
 	Spec        networkingv1.IngressSpec `json:"spec,omitempty"`
 }
 
+// GatewayRoute defines attributes to create Gateway API routes for the service
+// +kubebuilder:validation:XValidation:rule="!(has(self.enabled) && self.enabled) || (has(self.parentRefs) && size(self.parentRefs) > 0)",message="parentRefs are required when the gateway route is enabled"
+type GatewayRoute struct {
+	Enabled     *bool             `json:"enabled,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ParentRefs are the Gateways the routes are attached to
+	ParentRefs []GatewayParentReference `json:"parentRefs,omitempty"`
+	// Hostnames are the hostnames matched by the routes
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Rules are the HTTPRoute rules, all requests are forwarded to the service when empty
+	Rules []HTTPRouteRule `json:"rules,omitempty"`
+	// GRPC additionally creates a GRPCRoute forwarding gRPC requests to the service, for Triton based NIMs
+	GRPC GRPCRoute `json:"grpc,omitempty"`
+}
+
+// GatewayParentReference identifies the Gateway a route is attached to
+type GatewayParentReference struct {
+	Name string `json:"name"`
+	// Namespace of the Gateway, defaults to the namespace of the route
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName is the name of the Gateway listener to attach to
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// HTTPRouteRule defines the matches and the weighted backends of an HTTPRoute rule
+type HTTPRouteRule struct {
+	// Matches select the requests handled by the rule, all requests are matched when empty
+	Matches []HTTPRouteMatch `json:"matches,omitempty"`
+	// BackendRefs split the requests across weighted backends, requests are forwarded to the service when empty
+	BackendRefs []GatewayBackendReference `json:"backendRefs,omitempty"`
+}
+
+// HTTPRouteMatch defines the conditions a request must meet to be matched by a rule
+type HTTPRouteMatch struct {
+	Path *HTTPPathMatch `json:"path,omitempty"`
+	// Headers must all match the request headers
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+	// +kubebuilder:validation:Enum=GET;HEAD;POST;PUT;DELETE;CONNECT;OPTIONS;TRACE;PATCH
+	Method string `json:"method,omitempty"`
+}
+
+// HTTPPathMatch defines how the request path is matched
+type HTTPPathMatch struct {
+	// +kubebuilder:validation:Enum=Exact;PathPrefix;RegularExpression
+	// +kubebuilder:default:=PathPrefix
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value"`
+}
+
+// HTTPHeaderMatch defines how a request header is matched
+type HTTPHeaderMatch struct {
+	// +kubebuilder:validation:Enum=Exact;RegularExpression
+	// +kubebuilder:default:=Exact
+	Type  string `json:"type,omitempty"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GatewayBackendReference defines a weighted backend Service of a route rule
+type GatewayBackendReference struct {
+	// Name of the backend Service, defaults to the service of this resource
+	Name string `json:"name,omitempty"`
+	// Port of the backend Service, defaults to the service port when name is not set
+	Port int32 `json:"port,omitempty"`
+	// Weight is the proportion of requests forwarded to the backend
+	// +kubebuilder:validation:Minimum=0
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// GRPCRoute defines attributes to create a GRPCRoute for the service
+type GRPCRoute struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// Port is the gRPC port of the service pods, exposed by the service for the GRPCRoute
+	// +kubebuilder:default=8001
+	Port int32 `json:"port,omitempty"`
+}
+
+// DefaultGRPCPort is the gRPC port of Triton based NIMs
+const DefaultGRPCPort = 8001
+
+// IsEnabled returns true if the HTTPRoute is enabled
+func (g *GatewayRoute) IsEnabled() bool {
+	return g.Enabled != nil && *g.Enabled
+}
+
+// IsGRPCEnabled returns true if the GRPCRoute is enabled along with the HTTPRoute
+func (g *GatewayRoute) IsGRPCEnabled() bool {
+	return g.IsEnabled() && g.GRPC.Enabled != nil && *g.GRPC.Enabled
+}
+
+// GetGRPCPort returns the gRPC port exposed by the service for the GRPCRoute
+func (g *GatewayRoute) GetGRPCPort() int32 {
+	if g.GRPC.Port == 0 {
+		return DefaultGRPCPort
+	}
+	return g.GRPC.Port
+}
+
+// GetServicePorts returns the ports of the service, with the gRPC port when the GRPCRoute is enabled
+func (e *Expose) GetServicePorts(servicePort int32) []int32 {
+	ports := []int32{servicePort}
+	if e.GatewayRoute.IsGRPCEnabled() {
+		ports = append(ports, e.GatewayRoute.GetGRPCPort())
+	}
+	return ports
+}
+
+// GetRouteParams returns params to render the routes forwarding to the given service
+func (g *GatewayRoute) GetRouteParams(serviceName string, servicePort int32) *rendertypes.GatewayRouteParams {
+	params := &rendertypes.GatewayRouteParams{}
+
+	params.Enabled = g.IsEnabled()
+	params.GRPCEnabled = g.IsGRPCEnabled()
+	params.Hostnames = g.Hostnames
+	for _, parentRef := range g.ParentRefs {
+		params.ParentRefs = append(params.ParentRefs, rendertypes.GatewayParentRef{
+			Name:        parentRef.Name,
+			Namespace:   parentRef.Namespace,
+			SectionName: parentRef.SectionName,
+		})
+	}
+
+	serviceBackend := rendertypes.GatewayBackendRef{Name: serviceName, Port: servicePort}
+	params.GRPCBackendRef = rendertypes.GatewayBackendRef{Name: serviceName, Port: g.GetGRPCPort()}
+	if len(g.Rules) == 0 {
+		params.Rules = []rendertypes.HTTPRouteRule{{BackendRefs: []rendertypes.GatewayBackendRef{serviceBackend}}}
+		return params
+	}
+
+	for _, rule := range g.Rules {
+		renderRule := rendertypes.HTTPRouteRule{}
+		for _, match := range rule.Matches {
+			renderMatch := rendertypes.HTTPRouteMatch{Method: match.Method}
+			if match.Path != nil {
+				renderMatch.Path = &rendertypes.HTTPPathMatch{Type: match.Path.Type, Value: match.Path.Value}
+			}
+			for _, header := range match.Headers {
+				renderMatch.Headers = append(renderMatch.Headers, rendertypes.HTTPHeaderMatch{
+					Type:  header.Type,
+					Name:  header.Name,
+					Value: header.Value,
+				})
+			}
+			renderRule.Matches = append(renderRule.Matches, renderMatch)
+		}
+		for _, backendRef := range rule.BackendRefs {
+			renderBackendRef := rendertypes.GatewayBackendRef{Name: backendRef.Name, Port: backendRef.Port, Weight: backendRef.Weight}
+			if renderBackendRef.Name == "" {
+				renderBackendRef.Name = serviceName
+			}
+			if renderBackendRef.Port == 0 {
+				renderBackendRef.Port = servicePort
+			}
+			renderRule.BackendRefs = append(renderRule.BackendRefs, renderBackendRef)
+		}
+		if len(renderRule.BackendRefs) == 0 {
+			renderRule.BackendRefs = []rendertypes.GatewayBackendRef{serviceBackend}
+		}
+		params.Rules = append(params.Rules, renderRule)
+	}
+	return params
+}
+
+// PodDisruptionBudget defines attributes to create a pod disruption budget
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="minAvailable and maxUnavailable are mutually exclusive"
+type PodDisruptionBudget struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// MinAvailable is the number or percentage of pods that must remain available during voluntary disruptions
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of pods that can be unavailable during voluntary disruptions,
+	// defaults to 1 when minAvailable is not set
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// GetMaxUnavailable returns the maximum number of unavailable pods, defaulting to one pod when no budget is set
+func (p *PodDisruptionBudget) GetMaxUnavailable() *intstr.IntOrString {
+	if p.MinAvailable == nil && p.MaxUnavailable == nil {
+		maxUnavailable := intstr.FromInt32(1)
+		return &maxUnavailable
+	}
+	return p.MaxUnavailable
+}
+
+// NetworkPolicy defines attributes to create a network policy for the service pods
+type NetworkPolicy struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// Ingress lists the peers allowed to reach the service port, only pods in the same namespace are allowed when empty
+	Ingress []networkingv1.NetworkPolicyPeer `json:"ingress,omitempty"`
+	// Egress lists additional destinations the pods can reach, besides DNS and the dependencies derived from the spec
+	Egress []networkingv1.NetworkPolicyEgressRule `json:"egress,omitempty"`
+}
+
+// GetIngressRules returns the ingress rules allowing the configured peers to reach the service ports
+// and the service pods to reach each other
+func (p *NetworkPolicy) GetIngressRules(selectorLabels map[string]string, ports ...int32) []networkingv1.NetworkPolicyIngressRule {
+	from := p.Ingress
+	if len(from) == 0 {
+		from = []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}
+	}
+	var policyPorts []networkingv1.NetworkPolicyPort
+	for _, port := range ports {
+		policyPorts = append(policyPorts, networkPolicyPort(corev1.ProtocolTCP, port))
+	}
+	return []networkingv1.NetworkPolicyIngressRule{
+		{
+			Ports: policyPorts,
+			From:  from,
+		},
+		{
+			From: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: selectorLabels}}},
+		},
+	}
+}
+
+// GetEgressRules returns the egress rules allowing DNS lookups, traffic between the service pods,
+// the given dependencies and the configured destinations
+func (p *NetworkPolicy) GetEgressRules(selectorLabels map[string]string, dependencies ...networkingv1.NetworkPolicyEgressRule) []networkingv1.NetworkPolicyEgressRule {
+	rules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolUDP, 53),
+				networkPolicyPort(corev1.ProtocolTCP, 53),
+			},
+		},
+		{
+			To: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: selectorLabels}}},
+		},
+	}
+	rules = append(rules, dependencies...)
+	return append(rules, p.Egress...)
+}
+
+func networkPolicyPort(protocol corev1.Protocol, port int32) networkingv1.NetworkPolicyPort {
+	portValue := intstr.FromInt32(port)
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &portValue}
+}
+
+// egressRuleForEndpoint returns an egress rule to reach the given host and port,
+// in-cluster service hosts are restricted to the namespace of the service
+func egressRuleForEndpoint(host string, port int32, namespace string) networkingv1.NetworkPolicyEgressRule {
+	rule := networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{networkPolicyPort(corev1.ProtocolTCP, port)},
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		bits := 32
+		if ip.To4() == nil {
+			bits = 128
+		}
+		rule.To = []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: fmt.Sprintf("%s/%d", ip, bits)}}}
+		return rule
+	}
+
+	// Hosts are either <service>, <service>.<namespace>.svc[.<cluster-domain>] or external names
+	parts := strings.Split(host, ".")
+	switch {
+	case len(parts) == 1:
+	case len(parts) >= 3 && parts[2] == "svc":
+		namespace = parts[1]
+	default:
+		// External hosts cannot be matched by selectors, only the port is restricted
+		return rule
+	}
+	rule.To = []networkingv1.NetworkPolicyPeer{
+		{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: namespace}},
+		},
+	}
+	return rule
+}
+
+// egressRuleForURL returns an egress rule to reach the host of the given endpoint url
+func egressRuleForURL(endpoint string, namespace string) (networkingv1.NetworkPolicyEgressRule, bool) {
+	if endpoint == "" {
+		return networkingv1.NetworkPolicyEgressRule{}, false
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Hostname() == "" {
+		return networkingv1.NetworkPolicyEgressRule{}, false
+	}
+
+	port := int32(80)
+	if u.Scheme == "https" {
+		port = 443
+	}
+	if u.Port() != "" {
+		p, err := strconv.ParseInt(u.Port(), 10, 32)
+		if err != nil {
+			return networkingv1.NetworkPolicyEgressRule{}, false
+		}
+		port = int32(p)
+	}
+	return egressRuleForEndpoint(u.Hostname(), port, namespace), true
+}
+
 // IngressHost defines attributes for ingress host
 type IngressHost struct {
 	Host  string        `json:"host,omitempty"`
@@ -111,3 +548,130 @@ type CertConfig struct {
 	// MountPath is the path where the certificates should be mounted in the container.
 	MountPath string `json:"mountPath"`
 }
+
+const (
+	// ProxyCAConfigMapEnv is the operator environment variable with the default CA bundle ConfigMap, looked up in the namespace of each resource
+	ProxyCAConfigMapEnv = "PROXY_CA_CONFIGMAP"
+	// ProxyCABundleKey is the key of the CA bundle in the ConfigMap, as injected with the cluster-wide trusted CA bundle on OpenShift
+	ProxyCABundleKey = "ca-bundle.crt"
+	// ProxyCABundlePath is the directory the CA bundle is mounted in the containers
+	ProxyCABundlePath = "/etc/nim/proxy-ca"
+)
+
+// ProxySpec defines the proxy and CA bundle used by the pods to reach NGC and other external endpoints.
+// Unset fields default to the HTTP_PROXY, HTTPS_PROXY, NO_PROXY and PROXY_CA_CONFIGMAP operator environment variables
+type ProxySpec struct {
+	// HTTPProxy is the proxy for HTTP requests
+	HTTPProxy string `json:"httpProxy,omitempty"`
+	// HTTPSProxy is the proxy for HTTPS requests
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	// NoProxy is the comma separated list of hosts and domains reached without the proxy
+	NoProxy string `json:"noProxy,omitempty"`
+	// CertConfigMap is the name of the ConfigMap with the CA bundle in the ca-bundle.crt key, trusted instead of the system CAs,
+	// to reach the endpoints through TLS-intercepting proxies
+	CertConfigMap string `json:"certConfigMap,omitempty"`
+}
+
+// GetProxy returns the proxy configuration with the operator defaults for the unset fields, nil if none is configured
+func GetProxy(proxy *ProxySpec) *ProxySpec {
+	resolved := &ProxySpec{
+		HTTPProxy:     os.Getenv("HTTP_PROXY"),
+		HTTPSProxy:    os.Getenv("HTTPS_PROXY"),
+		NoProxy:       os.Getenv("NO_PROXY"),
+		CertConfigMap: os.Getenv(ProxyCAConfigMapEnv),
+	}
+	if proxy != nil {
+		if proxy.HTTPProxy != "" {
+			resolved.HTTPProxy = proxy.HTTPProxy
+		}
+		if proxy.HTTPSProxy != "" {
+			resolved.HTTPSProxy = proxy.HTTPSProxy
+		}
+		if proxy.NoProxy != "" {
+			resolved.NoProxy = proxy.NoProxy
+		}
+		if proxy.CertConfigMap != "" {
+			resolved.CertConfigMap = proxy.CertConfigMap
+		}
+	}
+	if *resolved == (ProxySpec{}) {
+		return nil
+	}
+	return resolved
+}
+
+// GetEnv returns the proxy env variables, in upper and lower case as the tools in the containers expect either
+func (p *ProxySpec) GetEnv() []corev1.EnvVar {
+	if p == nil {
+		return nil
+	}
+	var env []corev1.EnvVar
+	for _, proxyEnv := range []struct{ name, value string }{
+		{"HTTP_PROXY", p.HTTPProxy},
+		{"HTTPS_PROXY", p.HTTPSProxy},
+		{"NO_PROXY", p.NoProxy},
+	} {
+		if proxyEnv.value == "" {
+			continue
+		}
+		env = append(env,
+			corev1.EnvVar{Name: proxyEnv.name, Value: proxyEnv.value},
+			corev1.EnvVar{Name: strings.ToLower(proxyEnv.name), Value: proxyEnv.value},
+		)
+	}
+	if p.CertConfigMap != "" {
+		bundle := fmt.Sprintf("%s/%s", ProxyCABundlePath, ProxyCABundleKey)
+		env = append(env,
+			corev1.EnvVar{Name: "SSL_CERT_FILE", Value: bundle},
+			corev1.EnvVar{Name: "REQUESTS_CA_BUNDLE", Value: bundle},
+			corev1.EnvVar{Name: "CURL_CA_BUNDLE", Value: bundle},
+		)
+	}
+	return env
+}
+
+// GetEgressRules returns the network policy egress rules to reach the proxies
+func (p *ProxySpec) GetEgressRules(namespace string) []networkingv1.NetworkPolicyEgressRule {
+	if p == nil {
+		return nil
+	}
+	var rules []networkingv1.NetworkPolicyEgressRule
+	for _, proxy := range []string{p.HTTPProxy, p.HTTPSProxy} {
+		if rule, ok := egressRuleForURL(proxy, namespace); ok {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// GetVolumes returns the volume with the CA bundle
+func (p *ProxySpec) GetVolumes() []corev1.Volume {
+	if p == nil || p.CertConfigMap == "" {
+		return nil
+	}
+	return []corev1.Volume{
+		{
+			Name: "proxy-ca-bundle",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: p.CertConfigMap},
+					Items:                []corev1.KeyToPath{{Key: ProxyCABundleKey, Path: ProxyCABundleKey}},
+				},
+			},
+		},
+	}
+}
+
+// GetVolumeMounts returns the volume mount for the CA bundle
+func (p *ProxySpec) GetVolumeMounts() []corev1.VolumeMount {
+	if p == nil || p.CertConfigMap == "" {
+		return nil
+	}
+	return []corev1.VolumeMount{
+		{
+			Name:      "proxy-ca-bundle",
+			MountPath: ProxyCABundlePath,
+			ReadOnly:  true,
+		},
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"strconv"
 
 	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
 	utils "github.com/NVIDIA/k8s-nim-operator/internal/utils"
@@ -57,6 +58,8 @@ type NemoDatastoreSpec struct {
 	Command []string        `json:"command,omitempty"`
 	Args    []string        `json:"args,omitempty"`
 	Env     []corev1.EnvVar `json:"env,omitempty"`
+	// Proxy configures the proxy and CA bundle to reach external endpoints, defaults to the operator proxy configuration
+	Proxy *ProxySpec `json:"proxy,omitempty"`
 	// The name of an secret that contains authn for the NGC NIM service API
 	AuthSecret     string                       `json:"authSecret"`
 	Labels         map[string]string            `json:"labels,omitempty"`
@@ -71,6 +74,14 @@ type NemoDatastoreSpec struct {
 	StartupProbe   Probe                        `json:"startupProbe,omitempty"`
 	Scale          Autoscaling                  `json:"scale,omitempty"`
 	Metrics        Metrics                      `json:"metrics,omitempty"`
+	// Affinity is the scheduling affinity of the pods, podAffinity is still honored when affinity.podAffinity is not set
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints describe how the pods are spread across nodes and zones
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PDB limits the number of pods taken down at once by voluntary disruptions such as node drains
+	PDB PodDisruptionBudget `json:"pdb,omitempty"`
+	// NetworkPolicy restricts the pod traffic to the service port and the dependencies of the service
+	NetworkPolicy NetworkPolicy `json:"networkPolicy,omitempty"`
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:default:=1
 	Replicas     int    `json:"replicas,omitempty"`
@@ -302,6 +313,26 @@ func (n *NemoDatastore) GetPodAffinity() *corev1.PodAffinity {
 	return n.Spec.PodAffinity
 }
 
+// GetAffinity returns the affinity for the NemoDatastore instance, including the pod affinity set with podAffinity
+func (n *NemoDatastore) GetAffinity() *corev1.Affinity {
+	if n.Spec.Affinity == nil && n.Spec.PodAffinity == nil {
+		return nil
+	}
+	affinity := &corev1.Affinity{}
+	if n.Spec.Affinity != nil {
+		affinity = n.Spec.Affinity.DeepCopy()
+	}
+	if affinity.PodAffinity == nil && n.Spec.PodAffinity != nil {
+		affinity.PodAffinity = n.Spec.PodAffinity.DeepCopy()
+	}
+	return affinity
+}
+
+// GetTopologySpreadConstraints returns the topology spread constraints for the NemoDatastore instance
+func (n *NemoDatastore) GetTopologySpreadConstraints() []corev1.TopologySpreadConstraint {
+	return n.Spec.TopologySpreadConstraints
+}
+
 // GetContainerName returns name of the container for NemoDatastore deployment
 func (n *NemoDatastore) GetContainerName() string {
 	return fmt.Sprintf("%s-ctr", n.Name)
@@ -319,7 +350,8 @@ func (n *NemoDatastore) GetArgs() []string {
 
 // GetEnv returns merged slice of standard and user specified env variables
 func (n *NemoDatastore) GetEnv() []corev1.EnvVar {
-	return utils.MergeEnvVars(n.GetStandardEnv(), n.Spec.Env)
+	env := append(n.GetStandardEnv(), GetProxy(n.Spec.Proxy).GetEnv()...)
+	return utils.MergeEnvVars(env, n.Spec.Env)
 }
 
 // GetImage returns container image for the NemoDatastore
@@ -432,12 +464,13 @@ func (n *NemoDatastore) GetDefaultStartupProbe() *corev1.Probe {
 // GetVolumes returns volumes for the NemoDatastore container
 func (n *NemoDatastore) GetVolumes() []corev1.Volume {
 	volumes := []corev1.Volume{}
+	volumes = append(volumes, GetProxy(n.Spec.Proxy).GetVolumes()...)
 	return volumes
 }
 
 // GetVolumeMounts returns volumes for the NemoDatastore container
 func (n *NemoDatastore) GetVolumeMounts() []corev1.VolumeMount {
-	return []corev1.VolumeMount{}
+	return append([]corev1.VolumeMount{}, GetProxy(n.Spec.Proxy).GetVolumeMounts()...)
 }
 
 // GetServiceAccountName returns service account name for the NemoDatastore deployment
@@ -543,7 +576,8 @@ func (n *NemoDatastore) GetDeploymentParams() *rendertypes.DeploymentParams {
 	}
 	params.NodeSelector = n.GetNodeSelector()
 	params.Tolerations = n.GetTolerations()
-	params.Affinity = n.GetPodAffinity()
+	params.Affinity = n.GetAffinity()
+	params.TopologySpreadConstraints = n.GetTopologySpreadConstraints()
 	params.ImagePullSecrets = n.GetImagePullSecrets()
 	params.ImagePullPolicy = n.GetImagePullPolicy()
 
@@ -597,7 +631,8 @@ func (n *NemoDatastore) GetStatefulSetParams() *rendertypes.StatefulSetParams {
 	params.ServiceName = n.GetName()
 	params.NodeSelector = n.GetNodeSelector()
 	params.Tolerations = n.GetTolerations()
-	params.Affinity = n.GetPodAffinity()
+	params.Affinity = n.GetAffinity()
+	params.TopologySpreadConstraints = n.GetTopologySpreadConstraints()
 	params.ImagePullSecrets = n.GetImagePullSecrets()
 	params.ImagePullPolicy = n.GetImagePullPolicy()
 
@@ -644,6 +679,9 @@ func (n *NemoDatastore) GetServiceParams() *rendertypes.ServiceParams {
 	// Set service ports
 	params.Port = n.GetServicePort()
 	params.PortName = "service-port"
+	if n.Spec.Expose.GatewayRoute.IsGRPCEnabled() {
+		params.GRPCPort = n.Spec.Expose.GatewayRoute.GetGRPCPort()
+	}
 	return params
 }
 
@@ -661,6 +699,23 @@ func (n *NemoDatastore) GetIngressParams() *rendertypes.IngressParams {
 	return params
 }
 
+// IsGatewayRouteEnabled returns true if Gateway API routes are enabled for the NemoDatastore
+func (n *NemoDatastore) IsGatewayRouteEnabled() bool {
+	return n.Spec.Expose.GatewayRoute.IsEnabled()
+}
+
+// GetGatewayRouteParams returns params to render Gateway API routes from templates
+func (n *NemoDatastore) GetGatewayRouteParams() *rendertypes.GatewayRouteParams {
+	params := n.Spec.Expose.GatewayRoute.GetRouteParams(n.GetName(), n.GetServicePort())
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetGatewayRouteAnnotations()
+	return params
+}
+
 // GetRoleParams returns params to render Role from templates
 func (n *NemoDatastore) GetRoleParams() *rendertypes.RoleParams {
 	params := &rendertypes.RoleParams{}
@@ -695,6 +750,58 @@ func (n *NemoDatastore) GetRoleBindingParams() *rendertypes.RoleBindingParams {
 	return params
 }
 
+// IsPDBEnabled returns true if a pod disruption budget is enabled for the NemoDatastore deployment
+func (n *NemoDatastore) IsPDBEnabled() bool {
+	return n.Spec.PDB.Enabled != nil && *n.Spec.PDB.Enabled
+}
+
+// GetPDBParams returns params to render a PodDisruptionBudget from templates
+func (n *NemoDatastore) GetPDBParams() *rendertypes.PDBParams {
+	params := &rendertypes.PDBParams{}
+
+	params.Enabled = n.IsPDBEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNemoDatastoreAnnotations()
+	params.SelectorLabels = n.GetSelectorLabels()
+	params.MinAvailable = n.Spec.PDB.MinAvailable
+	params.MaxUnavailable = n.Spec.PDB.GetMaxUnavailable()
+	return params
+}
+
+// IsNetworkPolicyEnabled returns true if a network policy is enabled for the NemoDatastore pods
+func (n *NemoDatastore) IsNetworkPolicyEnabled() bool {
+	return n.Spec.NetworkPolicy.Enabled != nil && *n.Spec.NetworkPolicy.Enabled
+}
+
+// GetNetworkPolicyParams returns params to render a NetworkPolicy from templates
+func (n *NemoDatastore) GetNetworkPolicyParams() *rendertypes.NetworkPolicyParams {
+	params := &rendertypes.NetworkPolicyParams{}
+
+	params.Enabled = n.IsNetworkPolicyEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNemoDatastoreAnnotations()
+	params.SelectorLabels = n.GetSelectorLabels()
+
+	var dependencies []networkingv1.NetworkPolicyEgressRule
+	// The datastore depends on gitea and its postgres database
+	if rule, ok := egressRuleForURL(n.Spec.DataStoreParams.GiteaEndpoint, n.GetNamespace()); ok {
+		dependencies = append(dependencies, rule)
+	}
+	if port, err := strconv.ParseInt(n.Spec.DataStoreParams.DatabasePort, 10, 32); err == nil && n.Spec.DataStoreParams.DatabaseHost != "" {
+		dependencies = append(dependencies, egressRuleForEndpoint(n.Spec.DataStoreParams.DatabaseHost, int32(port), n.GetNamespace()))
+	}
+	params.Ingress = n.Spec.NetworkPolicy.GetIngressRules(n.GetSelectorLabels(), n.Spec.Expose.GetServicePorts(n.GetServicePort())...)
+	dependencies = append(dependencies, GetProxy(n.Spec.Proxy).GetEgressRules(n.GetNamespace())...)
+	params.Egress = n.Spec.NetworkPolicy.GetEgressRules(n.GetSelectorLabels(), dependencies...)
+	return params
+}
+
 // GetHPAParams returns params to render HPA from templates
 func (n *NemoDatastore) GetHPAParams() *rendertypes.HPAParams {
 	params := &rendertypes.HPAParams{}
@@ -765,6 +872,16 @@ func (n *NemoDatastore) GetIngressAnnotations() map[string]string {
 	return NemoDatastoreAnnotations
 }
 
+// GetGatewayRouteAnnotations returns annotations to apply to the Gateway API routes
+func (n *NemoDatastore) GetGatewayRouteAnnotations() map[string]string {
+	NemoDatastoreAnnotations := n.GetNemoDatastoreAnnotations()
+
+	if n.Spec.Expose.GatewayRoute.Annotations != nil {
+		return utils.MergeMaps(NemoDatastoreAnnotations, n.Spec.Expose.GatewayRoute.Annotations)
+	}
+	return NemoDatastoreAnnotations
+}
+
 func (n *NemoDatastore) GetServiceAnnotations() map[string]string {
 	NemoDatastoreAnnotations := n.GetNemoDatastoreAnnotations()
 
@@ -24,6 +24,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestNemoDatastore_GetPVCName(t *testing.T) {
@@ -1721,3 +1722,41 @@ func TestNemoDatastore_GetServiceMonitorAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestNemoDatastore_GetNetworkPolicyParams(t *testing.T) {
+	n := &NemoDatastore{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-datastore", Namespace: "nemo"},
+		Spec: NemoDatastoreSpec{
+			Expose:        Expose{Service: Service{Port: 8000}},
+			NetworkPolicy: NetworkPolicy{Enabled: ptr.To(true)},
+			DataStoreParams: NemoDataStoreParams{
+				GiteaEndpoint: "http://gitea-http.gitea.svc.cluster.local:3000/v1",
+				DatabaseHost:  "postgresql",
+				DatabasePort:  "5432",
+			},
+		},
+	}
+
+	params := n.GetNetworkPolicyParams()
+	if !params.Enabled {
+		t.Fatalf("NemoDatastore.GetNetworkPolicyParams().Enabled = false, want true")
+	}
+	if len(params.Ingress) != 2 || params.Ingress[0].Ports[0].Port.IntVal != 8000 {
+		t.Errorf("NemoDatastore.GetNetworkPolicyParams().Ingress = %v, want the service port first", params.Ingress)
+	}
+
+	// DNS, pod to pod, gitea and postgres
+	if len(params.Egress) != 4 {
+		t.Fatalf("NemoDatastore.GetNetworkPolicyParams().Egress = %v, want 4 rules", params.Egress)
+	}
+	wantNamespaces := []string{"gitea", "nemo"}
+	wantPorts := []int32{3000, 5432}
+	for i, rule := range params.Egress[2:] {
+		if got := rule.Ports[0].Port.IntVal; got != wantPorts[i] {
+			t.Errorf("egress rule %d port = %d, want %d", i, got, wantPorts[i])
+		}
+		if got := rule.To[0].NamespaceSelector.MatchLabels[corev1.LabelMetadataName]; got != wantNamespaces[i] {
+			t.Errorf("egress rule %d namespace = %s, want %s", i, got, wantNamespaces[i])
+		}
+	}
+}
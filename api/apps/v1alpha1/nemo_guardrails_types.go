@@ -57,6 +57,8 @@ type NemoGuardrailSpec struct {
 	Command []string        `json:"command,omitempty"`
 	Args    []string        `json:"args,omitempty"`
 	Env     []corev1.EnvVar `json:"env,omitempty"`
+	// Proxy configures the proxy and CA bundle to reach external endpoints, defaults to the operator proxy configuration
+	Proxy *ProxySpec `json:"proxy,omitempty"`
 	// The name of an secret that contains authn for the NGC NIM service API
 	AuthSecret string `json:"authSecret"`
 	// ConfigStore stores the config of the guardrail service
@@ -73,6 +75,14 @@ type NemoGuardrailSpec struct {
 	StartupProbe   Probe                        `json:"startupProbe,omitempty"`
 	Scale          Autoscaling                  `json:"scale,omitempty"`
 	Metrics        Metrics                      `json:"metrics,omitempty"`
+	// Affinity is the scheduling affinity of the pods, podAffinity is still honored when affinity.podAffinity is not set
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints describe how the pods are spread across nodes and zones
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PDB limits the number of pods taken down at once by voluntary disruptions such as node drains
+	PDB PodDisruptionBudget `json:"pdb,omitempty"`
+	// NetworkPolicy restricts the pod traffic to the service port and the dependencies of the service
+	NetworkPolicy NetworkPolicy `json:"networkPolicy,omitempty"`
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:default:=1
 	Replicas     int    `json:"replicas,omitempty"`
@@ -242,6 +252,26 @@ func (n *NemoGuardrail) GetPodAffinity() *corev1.PodAffinity {
 	return n.Spec.PodAffinity
 }
 
+// GetAffinity returns the affinity for the NemoGuardrail instance, including the pod affinity set with podAffinity
+func (n *NemoGuardrail) GetAffinity() *corev1.Affinity {
+	if n.Spec.Affinity == nil && n.Spec.PodAffinity == nil {
+		return nil
+	}
+	affinity := &corev1.Affinity{}
+	if n.Spec.Affinity != nil {
+		affinity = n.Spec.Affinity.DeepCopy()
+	}
+	if affinity.PodAffinity == nil && n.Spec.PodAffinity != nil {
+		affinity.PodAffinity = n.Spec.PodAffinity.DeepCopy()
+	}
+	return affinity
+}
+
+// GetTopologySpreadConstraints returns the topology spread constraints for the NemoGuardrail instance
+func (n *NemoGuardrail) GetTopologySpreadConstraints() []corev1.TopologySpreadConstraint {
+	return n.Spec.TopologySpreadConstraints
+}
+
 // GetContainerName returns name of the container for NemoGuardrail deployment
 func (n *NemoGuardrail) GetContainerName() string {
 	return fmt.Sprintf("%s-ctr", n.Name)
@@ -259,7 +289,8 @@ func (n *NemoGuardrail) GetArgs() []string {
 
 // GetEnv returns merged slice of standard and user specified env variables
 func (n *NemoGuardrail) GetEnv() []corev1.EnvVar {
-	return utils.MergeEnvVars(n.GetStandardEnv(), n.Spec.Env)
+	env := append(n.GetStandardEnv(), GetProxy(n.Spec.Proxy).GetEnv()...)
+	return utils.MergeEnvVars(env, n.Spec.Env)
 }
 
 // GetImage returns container image for the NemoGuardrail
@@ -396,6 +427,7 @@ func (n *NemoGuardrail) GetVolumes() []corev1.Volume {
 			},
 		})
 	}
+	volumes = append(volumes, GetProxy(n.Spec.Proxy).GetVolumes()...)
 	return volumes
 }
 
@@ -409,7 +441,7 @@ func (n *NemoGuardrail) GetVolumeMounts() []corev1.VolumeMount {
 		volumeMount.SubPath = n.Spec.ConfigStore.PVC.SubPath
 	}
 
-	return []corev1.VolumeMount{volumeMount}
+	return append([]corev1.VolumeMount{volumeMount}, GetProxy(n.Spec.Proxy).GetVolumeMounts()...)
 }
 
 // GetServiceAccountName returns service account name for the NemoGuardrail deployment
@@ -515,7 +547,8 @@ func (n *NemoGuardrail) GetDeploymentParams() *rendertypes.DeploymentParams {
 	}
 	params.NodeSelector = n.GetNodeSelector()
 	params.Tolerations = n.GetTolerations()
-	params.Affinity = n.GetPodAffinity()
+	params.Affinity = n.GetAffinity()
+	params.TopologySpreadConstraints = n.GetTopologySpreadConstraints()
 	params.ImagePullSecrets = n.GetImagePullSecrets()
 	params.ImagePullPolicy = n.GetImagePullPolicy()
 
@@ -569,7 +602,8 @@ func (n *NemoGuardrail) GetStatefulSetParams() *rendertypes.StatefulSetParams {
 	params.ServiceName = n.GetName()
 	params.NodeSelector = n.GetNodeSelector()
 	params.Tolerations = n.GetTolerations()
-	params.Affinity = n.GetPodAffinity()
+	params.Affinity = n.GetAffinity()
+	params.TopologySpreadConstraints = n.GetTopologySpreadConstraints()
 	params.ImagePullSecrets = n.GetImagePullSecrets()
 	params.ImagePullPolicy = n.GetImagePullPolicy()
 
@@ -616,6 +650,9 @@ func (n *NemoGuardrail) GetServiceParams() *rendertypes.ServiceParams {
 	// Set service ports
 	params.Port = n.GetServicePort()
 	params.PortName = "service-port"
+	if n.Spec.Expose.GatewayRoute.IsGRPCEnabled() {
+		params.GRPCPort = n.Spec.Expose.GatewayRoute.GetGRPCPort()
+	}
 	return params
 }
 
@@ -633,6 +670,23 @@ func (n *NemoGuardrail) GetIngressParams() *rendertypes.IngressParams {
 	return params
 }
 
+// IsGatewayRouteEnabled returns true if Gateway API routes are enabled for the NemoGuardrail
+func (n *NemoGuardrail) IsGatewayRouteEnabled() bool {
+	return n.Spec.Expose.GatewayRoute.IsEnabled()
+}
+
+// GetGatewayRouteParams returns params to render Gateway API routes from templates
+func (n *NemoGuardrail) GetGatewayRouteParams() *rendertypes.GatewayRouteParams {
+	params := n.Spec.Expose.GatewayRoute.GetRouteParams(n.GetName(), n.GetServicePort())
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetGatewayRouteAnnotations()
+	return params
+}
+
 // GetRoleParams returns params to render Role from templates
 func (n *NemoGuardrail) GetRoleParams() *rendertypes.RoleParams {
 	params := &rendertypes.RoleParams{}
@@ -667,6 +721,60 @@ func (n *NemoGuardrail) GetRoleBindingParams() *rendertypes.RoleBindingParams {
 	return params
 }
 
+// IsPDBEnabled returns true if a pod disruption budget is enabled for the NemoGuardrail deployment
+func (n *NemoGuardrail) IsPDBEnabled() bool {
+	return n.Spec.PDB.Enabled != nil && *n.Spec.PDB.Enabled
+}
+
+// GetPDBParams returns params to render a PodDisruptionBudget from templates
+func (n *NemoGuardrail) GetPDBParams() *rendertypes.PDBParams {
+	params := &rendertypes.PDBParams{}
+
+	params.Enabled = n.IsPDBEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNemoGuardrailAnnotations()
+	params.SelectorLabels = n.GetSelectorLabels()
+	params.MinAvailable = n.Spec.PDB.MinAvailable
+	params.MaxUnavailable = n.Spec.PDB.GetMaxUnavailable()
+	return params
+}
+
+// IsNetworkPolicyEnabled returns true if a network policy is enabled for the NemoGuardrail pods
+func (n *NemoGuardrail) IsNetworkPolicyEnabled() bool {
+	return n.Spec.NetworkPolicy.Enabled != nil && *n.Spec.NetworkPolicy.Enabled
+}
+
+// GetNetworkPolicyParams returns params to render a NetworkPolicy from templates
+func (n *NemoGuardrail) GetNetworkPolicyParams() *rendertypes.NetworkPolicyParams {
+	params := &rendertypes.NetworkPolicyParams{}
+
+	params.Enabled = n.IsNetworkPolicyEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNemoGuardrailAnnotations()
+	params.SelectorLabels = n.GetSelectorLabels()
+
+	var dependencies []networkingv1.NetworkPolicyEgressRule
+	// Guardrails forward the checked requests to the NIM endpoint
+	for _, env := range n.GetEnv() {
+		if env.Name != "NIM_ENDPOINT_URL" {
+			continue
+		}
+		if rule, ok := egressRuleForURL(env.Value, n.GetNamespace()); ok {
+			dependencies = append(dependencies, rule)
+		}
+	}
+	params.Ingress = n.Spec.NetworkPolicy.GetIngressRules(n.GetSelectorLabels(), n.Spec.Expose.GetServicePorts(n.GetServicePort())...)
+	dependencies = append(dependencies, GetProxy(n.Spec.Proxy).GetEgressRules(n.GetNamespace())...)
+	params.Egress = n.Spec.NetworkPolicy.GetEgressRules(n.GetSelectorLabels(), dependencies...)
+	return params
+}
+
 // GetHPAParams returns params to render HPA from templates
 func (n *NemoGuardrail) GetHPAParams() *rendertypes.HPAParams {
 	params := &rendertypes.HPAParams{}
@@ -737,6 +845,16 @@ func (n *NemoGuardrail) GetIngressAnnotations() map[string]string {
 	return NemoGuardrailAnnotations
 }
 
+// GetGatewayRouteAnnotations returns annotations to apply to the Gateway API routes
+func (n *NemoGuardrail) GetGatewayRouteAnnotations() map[string]string {
+	NemoGuardrailAnnotations := n.GetNemoGuardrailAnnotations()
+
+	if n.Spec.Expose.GatewayRoute.Annotations != nil {
+		return utils.MergeMaps(NemoGuardrailAnnotations, n.Spec.Expose.GatewayRoute.Annotations)
+	}
+	return NemoGuardrailAnnotations
+}
+
 func (n *NemoGuardrail) GetServiceAnnotations() map[string]string {
 	NemoGuardrailAnnotations := n.GetNemoGuardrailAnnotations()
 
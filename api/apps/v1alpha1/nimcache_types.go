@@ -18,9 +18,11 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -37,6 +39,8 @@ type NIMCacheSpec struct {
 	Storage NIMCacheStorage `json:"storage"`
 	// Resources defines the minimum resources required for the caching job to run(cpu, memory, gpu).
 	Resources Resources `json:"resources,omitempty"`
+	// DRA allocates GPUs to the caching job with a DRA resource claim, for engines built while caching
+	DRA *DRAResources `json:"dra,omitempty"`
 	// Tolerations for running the job to cache the NIM model
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
 	// NodeSelector is the node selector labels to schedule the caching job.
@@ -48,10 +52,56 @@ type NIMCacheSpec struct {
 	// CertConfig is the name of the ConfigMap containing the custom certificates.
 	// for secure communication.
 	CertConfig *CertConfig `json:"certConfig,omitempty"`
+	// Proxy configures the proxy and CA bundle for the caching job, defaults to the operator proxy configuration
+	Proxy *ProxySpec `json:"proxy,omitempty"`
 	// Env are the additional custom environment variabes for the caching job
 	Env []corev1.EnvVar `json:"env,omitempty"`
 	// RuntimeClassName is the runtimeclass for the caching job
 	RuntimeClassName string `json:"runtimeClassName,omitempty"`
+	// KServe defines how the cached model is registered with KServe, used only with the kserve platform
+	KServe *KServeCacheSpec `json:"kserve,omitempty"`
+	// Verification verifies the checksums of the cached files once the caching job completes
+	Verification *VerificationSpec `json:"verification,omitempty"`
+	// Progress reports the caching progress of each profile in the status, measured by a sidecar of the caching job
+	// which requires native sidecar containers, available by default since Kubernetes 1.29. The files of the NGC
+	// profiles are the files of their NGC repositories listed in the model manifest
+	Progress *ProgressSpec `json:"progress,omitempty"`
+}
+
+// ProgressSpec defines the caching progress reporting
+type ProgressSpec struct {
+	// Image is the container image of the sidecar measuring the cache, defaults to the operator image
+	Image string `json:"image,omitempty"`
+	// PullSecret for the sidecar image
+	PullSecret string `json:"pullSecret,omitempty"`
+}
+
+// VerificationSpec defines the integrity verification of the cached model
+type VerificationSpec struct {
+	// Checksums is the name of a ConfigMap with the expected checksums of the cached files, with one key per profile
+	// and one "<algorithm>:<checksum>  <path>" line per file, the algorithm being sha256, md5 or gitsha1 and the path
+	// relative to the cache. The Hugging Face, OCI and S3 sources provide their checksums when not set, and the NGC
+	// caches are verified against the content addressed names of their blobs
+	Checksums string `json:"checksums,omitempty"`
+	// Image is the container image verifying the cache, defaults to the operator image
+	Image string `json:"image,omitempty"`
+	// PullSecret for the verification image
+	PullSecret string `json:"pullSecret,omitempty"`
+}
+
+// KServeCacheSpec defines how the cached model is registered with KServe
+type KServeCacheSpec struct {
+	// LocalModelCache registers the cached model as a KServe LocalModelCache
+	LocalModelCache *LocalModelCacheSpec `json:"localModelCache,omitempty"`
+}
+
+// LocalModelCacheSpec defines the attributes of the KServe LocalModelCache for the cached model
+type LocalModelCacheSpec struct {
+	// NodeGroups are the names of the KServe LocalModelNodeGroups to cache the model on
+	// +kubebuilder:validation:MinItems=1
+	NodeGroups []string `json:"nodeGroups"`
+	// ModelSize is the size of the cached model, defaults to the size of the NIMCache PVC
+	ModelSize *resource.Quantity `json:"modelSize,omitempty"`
 }
 
 // NIMSource defines the source for caching NIM model
@@ -61,6 +111,15 @@ type NIMSource struct {
 
 	// NGCSource represents models stored in NVIDIA DataStore service
 	DataStore *DataStoreSource `json:"dataStore,omitempty"`
+
+	// HuggingFace represents models stored on the Hugging Face Hub or an HF-compatible mirror
+	HuggingFace *HuggingFaceSource `json:"huggingFace,omitempty"`
+
+	// S3 represents model artifacts stored in an S3-compatible object storage
+	S3 *S3Source `json:"s3,omitempty"`
+
+	// OCI represents model weights published as an OCI artifact in a registry
+	OCI *OCISource `json:"oci,omitempty"`
 }
 
 // NGCSource references a model stored on NVIDIA NGC
@@ -120,6 +179,107 @@ type DataStoreSource struct {
 	PullSecret string `json:"pullSecret,omitempty"`
 }
 
+const (
+	// DefaultHuggingFaceEndpoint is the Hugging Face Hub endpoint used when no endpoint override is set
+	DefaultHuggingFaceEndpoint = "https://huggingface.co"
+	// DefaultHuggingFaceRevision is the revision cached when no revision is set
+	DefaultHuggingFaceRevision = "main"
+)
+
+// HuggingFaceSource references a model repository on the Hugging Face Hub
+type HuggingFaceSource struct {
+	// RepoID is the model repository to cache, e.g. meta-llama/Meta-Llama-3-8B-Instruct
+	// +kubebuilder:validation:MinLength=1
+	RepoID string `json:"repoID"`
+	// Revision is the branch, tag or commit to cache, defaults to main
+	Revision string `json:"revision,omitempty"`
+	// Include are the glob patterns of the files to cache, all the files are cached when empty
+	Include []string `json:"include,omitempty"`
+	// Exclude are the glob patterns of the files to skip
+	Exclude []string `json:"exclude,omitempty"`
+	// The name of an existing secret containing the HF_TOKEN, required for private and gated repositories
+	AuthSecret string `json:"authSecret,omitempty"`
+	// Endpoint overrides the Hugging Face Hub endpoint, e.g. an internal HF-compatible mirror
+	Endpoint string `json:"endpoint,omitempty"`
+	// ModelPuller is the container image with the huggingface-cli to pull the model
+	ModelPuller string `json:"modelPuller"`
+	// PullSecret for the model puller image
+	PullSecret string `json:"pullSecret,omitempty"`
+}
+
+// GetEndpoint returns the Hugging Face Hub endpoint to pull the model from
+func (s *HuggingFaceSource) GetEndpoint() string {
+	if s.Endpoint == "" {
+		return DefaultHuggingFaceEndpoint
+	}
+	return strings.TrimSuffix(s.Endpoint, "/")
+}
+
+// GetRevision returns the revision of the repository to cache
+func (s *HuggingFaceSource) GetRevision() string {
+	if s.Revision == "" {
+		return DefaultHuggingFaceRevision
+	}
+	return s.Revision
+}
+
+const (
+	// DefaultS3Region is the region of the bucket when no region is set
+	DefaultS3Region = "us-east-1"
+)
+
+// S3Source references model artifacts stored under a prefix of an S3-compatible bucket
+type S3Source struct {
+	// Endpoint is the URL of the S3-compatible service, e.g. https://minio.example.com, defaults to the AWS S3 endpoint of the region.
+	// Custom CA certificates of the endpoint are trusted with the NIMCache certConfig
+	Endpoint string `json:"endpoint,omitempty"`
+	// Bucket is the bucket with the model artifacts
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+	// Prefix is the key prefix of the objects to cache, all the objects of the bucket are cached when empty
+	Prefix string `json:"prefix,omitempty"`
+	// Region is the region of the bucket, defaults to us-east-1
+	Region string `json:"region,omitempty"`
+	// The name of an existing secret containing the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and optional AWS_SESSION_TOKEN,
+	// the objects are read anonymously when not set
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+	// ModelPuller is the container image syncing the objects, defaults to the operator image
+	ModelPuller string `json:"modelPuller,omitempty"`
+	// PullSecret for the model puller image
+	PullSecret string `json:"pullSecret,omitempty"`
+}
+
+// GetRegion returns the region of the bucket
+func (s *S3Source) GetRegion() string {
+	if s.Region == "" {
+		return DefaultS3Region
+	}
+	return s.Region
+}
+
+// GetEndpoint returns the URL of the S3-compatible service
+func (s *S3Source) GetEndpoint() string {
+	if s.Endpoint == "" {
+		return fmt.Sprintf("https://s3.%s.amazonaws.com", s.GetRegion())
+	}
+	return strings.TrimSuffix(s.Endpoint, "/")
+}
+
+// OCISource references model weights published as an OCI artifact, e.g. pushed with ORAS
+type OCISource struct {
+	// Reference is the artifact to cache with a tag or a digest, e.g. registry.example.com/models/llama3:1.0
+	// +kubebuilder:validation:MinLength=1
+	Reference string `json:"reference"`
+	// PullSecrets are the names of the docker config secrets to pull the artifact, as for the image pull secrets
+	PullSecrets []string `json:"pullSecrets,omitempty"`
+	// PlainHTTP pulls the artifact from the registry over HTTP instead of HTTPS
+	PlainHTTP bool `json:"plainHTTP,omitempty"`
+	// ModelPuller is the container image extracting the artifact, defaults to the operator image
+	ModelPuller string `json:"modelPuller,omitempty"`
+	// PullSecret for the model puller image
+	PullSecret string `json:"pullSecret,omitempty"`
+}
+
 // NIMCacheStorage defines the attributes of various storage targets used to store the model
 type NIMCacheStorage struct {
 	// PersistentVolumeClaim is the pvc volume used for caching NIM
@@ -145,12 +305,109 @@ type PersistentVolumeClaim struct {
 
 // NIMCacheStatus defines the observed state of NIMCache
 type NIMCacheStatus struct {
-	State      string             `json:"state,omitempty"`
-	PVC        string             `json:"pvc,omitempty"`
-	Profiles   []NIMProfile       `json:"profiles,omitempty"`
+	State string `json:"state,omitempty"`
+	PVC   string `json:"pvc,omitempty"`
+	// StorageURI is the KServe storage URI of the cached model, set only with the kserve platform
+	StorageURI string       `json:"storageURI,omitempty"`
+	Profiles   []NIMProfile `json:"profiles,omitempty"`
+	// HuggingFace is the resolved revision and files of the cached Hugging Face repository
+	HuggingFace *HuggingFaceStatus `json:"huggingFace,omitempty"`
+	// S3 is the result of the sync of the cached S3 prefix
+	S3 *S3Status `json:"s3,omitempty"`
+	// OCI is the resolved digest and files of the cached OCI artifact
+	OCI *OCIStatus `json:"oci,omitempty"`
+	// Verification is the result of the integrity verification of each cached profile
+	Verification []ProfileVerification `json:"verification,omitempty"`
+	// Progress is the caching progress of each profile
+	Progress []ProfileProgress `json:"progress,omitempty"`
+	// TotalBytes is the size of the cache in bytes
+	TotalBytes int64              `json:"totalBytes,omitempty"`
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 }
 
+// ProfileProgressState is the caching state of a profile
+type ProfileProgressState string
+
+const (
+	// ProfileProgressPending indicates that the profile is not downloaded yet
+	ProfileProgressPending ProfileProgressState = "Pending"
+	// ProfileProgressDownloading indicates that the profile is being downloaded
+	ProfileProgressDownloading ProfileProgressState = "Downloading"
+	// ProfileProgressComplete indicates that the profile is cached
+	ProfileProgressComplete ProfileProgressState = "Complete"
+	// ProfileProgressFailed indicates that the caching job failed before the profile was cached
+	ProfileProgressFailed ProfileProgressState = "Failed"
+)
+
+// ProfileProgress defines the caching progress of a profile
+type ProfileProgress struct {
+	// Name is the profile, huggingface, s3, oci or datastore for the files of these sources
+	Name string `json:"name"`
+	// State is the caching state of the profile, NGC profiles are Complete once the caching job completes as their
+	// size is not known from the manifest
+	State ProfileProgressState `json:"state,omitempty"`
+	// BytesDownloaded is the size of the cached files of the profile, the files shared by several NGC profiles are
+	// measured in each of them
+	BytesDownloaded int64 `json:"bytesDownloaded,omitempty"`
+	// TotalBytes is the size of the profile when known from the source
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+	// StartTime is the time the download of the profile started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// FinishTime is the time the profile was cached or failed
+	FinishTime *metav1.Time `json:"finishTime,omitempty"`
+}
+
+// ProfileVerification defines the integrity verification result of a cached profile
+type ProfileVerification struct {
+	// Profile is the verified profile, huggingface or oci for the files of these sources
+	Profile string `json:"profile"`
+	// Files is the number of files with an expected checksum
+	Files int64 `json:"files,omitempty"`
+	// VerifiedFiles is the number of files matching their checksum
+	VerifiedFiles int64 `json:"verifiedFiles,omitempty"`
+	// FailedFiles are the files missing or not matching their checksum, truncated to the first ones
+	FailedFiles []string `json:"failedFiles,omitempty"`
+}
+
+// HuggingFaceStatus defines the Hugging Face repository content that was cached
+type HuggingFaceStatus struct {
+	// RepoID is the cached model repository
+	RepoID string `json:"repoID,omitempty"`
+	// Revision is the commit the requested revision resolved to, the caching job is pinned to it
+	Revision string `json:"revision,omitempty"`
+	// Files are the repository files matching the include and exclude patterns
+	Files []string `json:"files,omitempty"`
+	// Size is the total size of the files in bytes
+	Size int64 `json:"size,omitempty"`
+	// SourceHash is the hash of the source spec the revision was resolved for, the revision is resolved again when it changes
+	SourceHash string `json:"sourceHash,omitempty"`
+}
+
+// S3Status defines the objects synced from the S3 bucket
+type S3Status struct {
+	// Objects is the number of objects under the prefix
+	Objects int64 `json:"objects,omitempty"`
+	// VerifiedObjects is the number of objects matching their ETag or checksum, objects uploaded in multiple parts
+	// without a checksum cannot be verified
+	VerifiedObjects int64 `json:"verifiedObjects,omitempty"`
+	// BytesTransferred is the number of bytes downloaded, objects already in the cache are not downloaded again
+	BytesTransferred int64 `json:"bytesTransferred,omitempty"`
+}
+
+// OCIStatus defines the OCI artifact that was cached
+type OCIStatus struct {
+	// Reference is the cached artifact
+	Reference string `json:"reference,omitempty"`
+	// Digest is the digest of the artifact manifest the reference resolved to, the caching job is pinned to it
+	Digest string `json:"digest,omitempty"`
+	// Files are the titles of the artifact layers
+	Files []string `json:"files,omitempty"`
+	// Size is the total size of the artifact layers in bytes
+	Size int64 `json:"size,omitempty"`
+	// SourceHash is the hash of the source spec the digest was resolved for, the digest is resolved again when it changes
+	SourceHash string `json:"sourceHash,omitempty"`
+}
+
 // NIMProfile defines the profiles that were cached
 type NIMProfile struct {
 	Name    string            `json:"name,omitempty"`
@@ -180,6 +437,8 @@ const (
 	NimCacheConditionPVCCreated = "NIM_CACHE_PVC_CREATED"
 	// NimCacheConditionReconcileFailed indicated that error occured while reconciling NIMCache object
 	NimCacheConditionReconcileFailed = "NIM_CACHE_RECONCILE_FAILED"
+	// NimCacheConditionVerified indicates that the checksums of the cached files are verified.
+	NimCacheConditionVerified = "NIM_CACHE_VERIFIED"
 
 	// NimCacheStatusNotReady indicates that cache is not ready
 	NimCacheStatusNotReady = "NotReady"
@@ -219,6 +478,26 @@ func (s *NIMSource) EnvFromSecrets() []v1.EnvFromSource {
 				},
 			},
 		}
+	} else if s.HuggingFace != nil && s.HuggingFace.AuthSecret != "" {
+		return []corev1.EnvFromSource{
+			{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: s.HuggingFace.AuthSecret,
+					},
+				},
+			},
+		}
+	} else if s.S3 != nil && s.S3.CredentialsSecret != "" {
+		return []corev1.EnvFromSource{
+			{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: s.S3.CredentialsSecret,
+					},
+				},
+			},
+		}
 	}
 	// no secrets to source the env variables
 	return []corev1.EnvFromSource{}
@@ -229,6 +508,7 @@ func (s *NIMSource) EnvFromSecrets() []v1.EnvFromSource {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.state`,priority=0
 // +kubebuilder:printcolumn:name="PVC",type=string,JSONPath=`.status.pvc`,priority=0
+// +kubebuilder:printcolumn:name="Size",type=integer,JSONPath=`.status.totalBytes`,priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",format="date-time",JSONPath=".metadata.creationTimestamp",priority=0
 
 // NIMCache is the Schema for the nimcaches API
@@ -287,6 +567,77 @@ func (n *NIMCache) GetRuntimeClassName() *string {
 	return &n.Spec.RuntimeClassName
 }
 
+// GetStorageURI returns the KServe storage URI for the PVC backing the NIMCache instance
+func (n *NIMCache) GetStorageURI() string {
+	if n.Status.PVC == "" {
+		return ""
+	}
+	if subPath := strings.Trim(n.Spec.Storage.PVC.SubPath, "/"); subPath != "" {
+		return fmt.Sprintf("pvc://%s/%s", n.Status.PVC, subPath)
+	}
+	return fmt.Sprintf("pvc://%s", n.Status.PVC)
+}
+
+// IsVerificationEnabled returns true if the checksums of the cached files have to be verified
+func (n *NIMCache) IsVerificationEnabled() bool {
+	return n.Spec.Verification != nil
+}
+
+// IsVerified returns true if the checksums of the cached files are verified
+func (n *NIMCache) IsVerified() bool {
+	return meta.IsStatusConditionTrue(n.Status.Conditions, NimCacheConditionVerified)
+}
+
+// GetVerificationJobName returns the name of the job verifying the cached files
+func (n *NIMCache) GetVerificationJobName() string {
+	return fmt.Sprintf("%s-verify-job", n.GetName())
+}
+
+// GetChecksumsConfigMapName returns the name of the ConfigMap with the checksums of the cached files
+func (n *NIMCache) GetChecksumsConfigMapName() string {
+	if n.Spec.Verification != nil && n.Spec.Verification.Checksums != "" {
+		return n.Spec.Verification.Checksums
+	}
+	return fmt.Sprintf("%s-checksums", n.GetName())
+}
+
+// HasChecksumsSource returns true if the expected checksums of the cached files are known, either set by the user
+// or provided by the NGC, Hugging Face, OCI and S3 sources
+func (n *NIMCache) HasChecksumsSource() bool {
+	if n.Spec.Verification != nil && n.Spec.Verification.Checksums != "" {
+		return true
+	}
+	return n.Spec.Source.DataStore == nil
+}
+
+// IsProgressEnabled returns true if the caching progress is reported in the status
+func (n *NIMCache) IsProgressEnabled() bool {
+	return n.Spec.Progress != nil
+}
+
+// IsLocalModelCacheEnabled returns true if the cached model has to be registered as a KServe LocalModelCache
+func (n *NIMCache) IsLocalModelCacheEnabled() bool {
+	return n.Spec.KServe != nil && n.Spec.KServe.LocalModelCache != nil
+}
+
+// GetLocalModelCacheName returns the name of the cluster-scoped KServe LocalModelCache for the NIMCache instance
+func (n *NIMCache) GetLocalModelCacheName() string {
+	return fmt.Sprintf("%s-%s", n.GetNamespace(), n.GetName())
+}
+
+// IsDRAEnabled returns true if the GPUs of the NIMCache Job are allocated with a DRA resource claim
+func (n *NIMCache) IsDRAEnabled() bool {
+	return n.Spec.DRA != nil
+}
+
+// GetResourceClaimTemplateName returns the name of the ResourceClaimTemplate of the NIMCache Job GPUs
+func (n *NIMCache) GetResourceClaimTemplateName() string {
+	if n.Spec.DRA != nil && n.Spec.DRA.ResourceClaimTemplateName != "" {
+		return n.Spec.DRA.ResourceClaimTemplateName
+	}
+	return fmt.Sprintf("%s-gpu", n.GetName())
+}
+
 // +kubebuilder:object:root=true
 
 // NIMCacheList contains a list of NIMCache
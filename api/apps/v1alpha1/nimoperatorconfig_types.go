@@ -0,0 +1,241 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// NIMOperatorConfigName is the name of the NIMOperatorConfig consumed by the operator
+	NIMOperatorConfigName = "default"
+	// DefaultMirrorSource is the registry rewritten by a registry mirror without source
+	DefaultMirrorSource = "nvcr.io"
+)
+
+// NIMOperatorConfigSpec defines the operator-wide defaults, the values set in each resource take precedence
+type NIMOperatorConfigSpec struct {
+	// RegistryMirrors rewrite the registry of the images, e.g. nvcr.io to an internal mirror
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty"`
+	// PullSecrets are the default image pull secrets, they must exist in the namespace of each resource
+	PullSecrets []string `json:"pullSecrets,omitempty"`
+	// Proxy is the default proxy and CA bundle, it takes precedence over the operator environment variables
+	Proxy *ProxySpec `json:"proxy,omitempty"`
+	// GPUResourceName is the default GPU resource assigned to the NIMs, e.g. nvidia.com/mig-3g.40gb
+	GPUResourceName corev1.ResourceName `json:"gpuResourceName,omitempty"`
+	// StorageClass is the default storage class of the PVCs created by the operator
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
+// RegistryMirror defines a registry served by a mirror
+type RegistryMirror struct {
+	// Source is the registry to rewrite
+	// +kubebuilder:default:=nvcr.io
+	Source string `json:"source,omitempty"`
+	// Mirror is the registry, with an optional path prefix, to pull the images from
+	Mirror string `json:"mirror"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="the NIMOperatorConfig must be named default"
+// +kubebuilder:printcolumn:name="Age",type="date",format="date-time",JSONPath=".metadata.creationTimestamp",priority=0
+
+// NIMOperatorConfig is the Schema for the nimoperatorconfigs API
+type NIMOperatorConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NIMOperatorConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NIMOperatorConfigList contains a list of NIMOperatorConfig
+type NIMOperatorConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NIMOperatorConfig `json:"items"`
+}
+
+// GetImage returns the image rewritten to the first matching registry mirror
+func (c *NIMOperatorConfig) GetImage(image string) string {
+	if c == nil || image == "" {
+		return image
+	}
+	for _, mirror := range c.Spec.RegistryMirrors {
+		source := strings.TrimSuffix(mirror.Source, "/")
+		if source == "" {
+			source = DefaultMirrorSource
+		}
+		if image == source || strings.HasPrefix(image, source+"/") {
+			return strings.TrimSuffix(mirror.Mirror, "/") + strings.TrimPrefix(image, source)
+		}
+	}
+	return image
+}
+
+// GetPullSecrets returns the given pull secrets, or the default pull secrets when none are given
+func (c *NIMOperatorConfig) GetPullSecrets(pullSecrets []string) []string {
+	if c == nil || len(pullSecrets) > 0 {
+		return pullSecrets
+	}
+	return c.Spec.PullSecrets
+}
+
+// GetPullSecret returns the given pull secret, or the first default pull secret when not given
+func (c *NIMOperatorConfig) GetPullSecret(pullSecret string) string {
+	if c == nil || pullSecret != "" || len(c.Spec.PullSecrets) == 0 {
+		return pullSecret
+	}
+	return c.Spec.PullSecrets[0]
+}
+
+// GetStorageClass returns the given storage class, or the default storage class when not given
+func (c *NIMOperatorConfig) GetStorageClass(storageClass string) string {
+	if c == nil || storageClass != "" {
+		return storageClass
+	}
+	return c.Spec.StorageClass
+}
+
+// GetProxy returns the given proxy configuration with the default proxy for the unset fields
+func (c *NIMOperatorConfig) GetProxy(proxy *ProxySpec) *ProxySpec {
+	if c == nil || c.Spec.Proxy == nil {
+		return proxy
+	}
+	merged := c.Spec.Proxy.DeepCopy()
+	if proxy != nil {
+		if proxy.HTTPProxy != "" {
+			merged.HTTPProxy = proxy.HTTPProxy
+		}
+		if proxy.HTTPSProxy != "" {
+			merged.HTTPSProxy = proxy.HTTPSProxy
+		}
+		if proxy.NoProxy != "" {
+			merged.NoProxy = proxy.NoProxy
+		}
+		if proxy.CertConfigMap != "" {
+			merged.CertConfigMap = proxy.CertConfigMap
+		}
+	}
+	return merged
+}
+
+// ApplyToNIMService sets the operator defaults for the fields unset in the NIMService spec
+func (c *NIMOperatorConfig) ApplyToNIMService(n *NIMService) {
+	if c == nil {
+		return
+	}
+	n.Spec.Image.Repository = c.GetImage(n.Spec.Image.Repository)
+	n.Spec.Image.PullSecrets = c.GetPullSecrets(n.Spec.Image.PullSecrets)
+	n.Spec.Proxy = c.GetProxy(n.Spec.Proxy)
+	if n.Spec.GPUResourceName == "" {
+		n.Spec.GPUResourceName = c.Spec.GPUResourceName
+	}
+	n.Spec.Storage.PVC.StorageClass = c.GetStorageClass(n.Spec.Storage.PVC.StorageClass)
+	for i := range n.Spec.VolumeClaimTemplates {
+		n.Spec.VolumeClaimTemplates[i].StorageClass = c.GetStorageClass(n.Spec.VolumeClaimTemplates[i].StorageClass)
+	}
+	if n.Spec.LoRA != nil {
+		n.Spec.LoRA.Storage.StorageClass = c.GetStorageClass(n.Spec.LoRA.Storage.StorageClass)
+		for i := range n.Spec.LoRA.Adapters {
+			adapter := &n.Spec.LoRA.Adapters[i]
+			if adapter.NGC != nil {
+				adapter.NGC.ModelPuller = c.GetImage(adapter.NGC.ModelPuller)
+				adapter.NGC.PullSecret = c.GetPullSecret(adapter.NGC.PullSecret)
+			}
+			if adapter.DataStore != nil {
+				adapter.DataStore.ModelPuller = c.GetImage(adapter.DataStore.ModelPuller)
+				adapter.DataStore.PullSecret = c.GetPullSecret(adapter.DataStore.PullSecret)
+			}
+		}
+	}
+}
+
+// ApplyToNIMCache sets the operator defaults for the fields unset in the NIMCache spec
+func (c *NIMOperatorConfig) ApplyToNIMCache(n *NIMCache) {
+	if c == nil {
+		return
+	}
+	if n.Spec.Source.NGC != nil {
+		n.Spec.Source.NGC.ModelPuller = c.GetImage(n.Spec.Source.NGC.ModelPuller)
+		n.Spec.Source.NGC.PullSecret = c.GetPullSecret(n.Spec.Source.NGC.PullSecret)
+	}
+	if n.Spec.Source.DataStore != nil {
+		n.Spec.Source.DataStore.ModelPuller = c.GetImage(n.Spec.Source.DataStore.ModelPuller)
+		n.Spec.Source.DataStore.PullSecret = c.GetPullSecret(n.Spec.Source.DataStore.PullSecret)
+	}
+	if n.Spec.Source.HuggingFace != nil {
+		n.Spec.Source.HuggingFace.ModelPuller = c.GetImage(n.Spec.Source.HuggingFace.ModelPuller)
+		n.Spec.Source.HuggingFace.PullSecret = c.GetPullSecret(n.Spec.Source.HuggingFace.PullSecret)
+	}
+	if n.Spec.Source.S3 != nil {
+		n.Spec.Source.S3.ModelPuller = c.GetImage(n.Spec.Source.S3.ModelPuller)
+		n.Spec.Source.S3.PullSecret = c.GetPullSecret(n.Spec.Source.S3.PullSecret)
+	}
+	if n.Spec.Source.OCI != nil {
+		n.Spec.Source.OCI.Reference = c.GetImage(n.Spec.Source.OCI.Reference)
+		n.Spec.Source.OCI.PullSecrets = c.GetPullSecrets(n.Spec.Source.OCI.PullSecrets)
+		n.Spec.Source.OCI.ModelPuller = c.GetImage(n.Spec.Source.OCI.ModelPuller)
+		n.Spec.Source.OCI.PullSecret = c.GetPullSecret(n.Spec.Source.OCI.PullSecret)
+	}
+	if n.Spec.Verification != nil {
+		n.Spec.Verification.Image = c.GetImage(n.Spec.Verification.Image)
+		n.Spec.Verification.PullSecret = c.GetPullSecret(n.Spec.Verification.PullSecret)
+	}
+	if n.Spec.Progress != nil {
+		n.Spec.Progress.Image = c.GetImage(n.Spec.Progress.Image)
+		n.Spec.Progress.PullSecret = c.GetPullSecret(n.Spec.Progress.PullSecret)
+	}
+	n.Spec.Proxy = c.GetProxy(n.Spec.Proxy)
+	n.Spec.Storage.PVC.StorageClass = c.GetStorageClass(n.Spec.Storage.PVC.StorageClass)
+}
+
+// ApplyToNemoGuardrail sets the operator defaults for the fields unset in the NemoGuardrail spec
+func (c *NIMOperatorConfig) ApplyToNemoGuardrail(n *NemoGuardrail) {
+	if c == nil {
+		return
+	}
+	n.Spec.Image.Repository = c.GetImage(n.Spec.Image.Repository)
+	n.Spec.Image.PullSecrets = c.GetPullSecrets(n.Spec.Image.PullSecrets)
+	n.Spec.Proxy = c.GetProxy(n.Spec.Proxy)
+	if n.Spec.ConfigStore.PVC != nil {
+		n.Spec.ConfigStore.PVC.StorageClass = c.GetStorageClass(n.Spec.ConfigStore.PVC.StorageClass)
+	}
+}
+
+// ApplyToNemoDatastore sets the operator defaults for the fields unset in the NemoDatastore spec
+func (c *NIMOperatorConfig) ApplyToNemoDatastore(n *NemoDatastore) {
+	if c == nil {
+		return
+	}
+	n.Spec.Image.Repository = c.GetImage(n.Spec.Image.Repository)
+	n.Spec.Image.PullSecrets = c.GetPullSecrets(n.Spec.Image.PullSecrets)
+	n.Spec.DataStoreParams.InitContainerImage = c.GetImage(n.Spec.DataStoreParams.InitContainerImage)
+	n.Spec.Proxy = c.GetProxy(n.Spec.Proxy)
+}
+
+func init() {
+	SchemeBuilder.Register(&NIMOperatorConfig{}, &NIMOperatorConfigList{})
+}
@@ -20,6 +20,11 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
 	utils "github.com/NVIDIA/k8s-nim-operator/internal/utils"
@@ -28,8 +33,54 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
+)
+
+// autoscalingPreset defines the custom metric of an autoscaling preset and the Prometheus series it is served from
+type autoscalingPreset struct {
+	metric string
+	series string
+	query  string
+	target string
+}
+
+// autoscalingPresets are the NIM inference metrics available as autoscaling presets
+var autoscalingPresets = map[AutoscalingPresetName]autoscalingPreset{
+	AutoscalingPresetQueueDepth: {
+		metric: "num_requests_waiting",
+		series: "num_requests_waiting",
+		query:  "sum(<<.Series>>{<<.LabelMatchers>>}) by (<<.GroupBy>>)",
+		target: "10",
+	},
+	AutoscalingPresetKVCacheUtilization: {
+		metric: "gpu_cache_usage_perc",
+		series: "gpu_cache_usage_perc",
+		query:  "avg(<<.Series>>{<<.LabelMatchers>>}) by (<<.GroupBy>>)",
+		target: "750m",
+	},
+	AutoscalingPresetTimeToFirstToken: {
+		metric: "time_to_first_token_seconds",
+		series: "time_to_first_token_seconds_sum",
+		query: "sum(rate(<<.Series>>{<<.LabelMatchers>>}[2m])) by (<<.GroupBy>>) / " +
+			"sum(rate(time_to_first_token_seconds_count{<<.LabelMatchers>>}[2m])) by (<<.GroupBy>>)",
+		target: "2",
+	},
+}
+
+const (
+	// DefaultGPUResourceName is the GPU resource assigned to NIMService containers by default
+	DefaultGPUResourceName = corev1.ResourceName("nvidia.com/gpu")
+	// GPUResourceNameEnv is the operator environment variable overriding the default GPU resource
+	GPUResourceNameEnv = "GPU_RESOURCE_NAME"
+	// MIGResourcePrefix is the prefix of the MIG device resources advertised with the mixed MIG strategy
+	MIGResourcePrefix = "nvidia.com/mig-"
+	// AutoProfile selects the cached profile to serve based on the GPUs available in the cluster
+	AutoProfile = "auto"
+	// DefaultPrometheusAdapterConfigKey is the key of the configuration file in the prometheus-adapter ConfigMap
+	DefaultPrometheusAdapterConfigKey = "config.yaml"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -49,6 +100,66 @@ const (
 	NIMServiceStatusReady = "Ready"
 	// NIMServiceStatusFailed indicates that NIM deployment has failed
 	NIMServiceStatusFailed = "Failed"
+
+	// KServeContainerName is the name of the model container in the KServe ServingRuntime
+	KServeContainerName = "kserve-container"
+
+	// DeploymentKindDeployment deploys the NIMService as a Deployment
+	DeploymentKindDeployment = "Deployment"
+	// DeploymentKindStatefulSet deploys the NIMService as a StatefulSet
+	DeploymentKindStatefulSet = "StatefulSet"
+
+	// RolloutStrategyCanary shifts traffic to the new revision in weighted steps
+	RolloutStrategyCanary = "Canary"
+	// RolloutStrategyBlueGreen switches all traffic to the new revision once it is ready
+	RolloutStrategyBlueGreen = "BlueGreen"
+
+	// RolloutPhaseStable indicates that all traffic is served by the stable revision
+	RolloutPhaseStable = "Stable"
+	// RolloutPhaseProgressing indicates that the new revision is being deployed and validated
+	RolloutPhaseProgressing = "Progressing"
+	// RolloutPhasePromoting indicates that the stable deployment is being updated to the new revision
+	RolloutPhasePromoting = "Promoting"
+	// RolloutPhaseRolledBack indicates that the new revision failed and was rolled back
+	RolloutPhaseRolledBack = "RolledBack"
+	// RolloutPhaseFailed indicates that the new revision failed and automatic rollback is disabled
+	RolloutPhaseFailed = "Failed"
+
+	// RolloutTrafficStable routes traffic to the stable revision only
+	RolloutTrafficStable = "Stable"
+	// RolloutTrafficSplit routes traffic to both revisions proportionally to their replicas
+	RolloutTrafficSplit = "Split"
+	// RolloutTrafficCandidate routes traffic to the candidate revision only
+	RolloutTrafficCandidate = "Candidate"
+
+	// LoRAAdapterStatePending indicates that the adapter source is not available yet
+	LoRAAdapterStatePending = "Pending"
+	// LoRAAdapterStateDownloading indicates that the adapter is being downloaded
+	LoRAAdapterStateDownloading = "Downloading"
+	// LoRAAdapterStateReady indicates that the adapter is available to NIM
+	LoRAAdapterStateReady = "Ready"
+	// LoRAAdapterStateFailed indicates that the adapter download failed
+	LoRAAdapterStateFailed = "Failed"
+
+	// LoRAAdaptersPath is the directory NIM loads LoRA adapters from
+	LoRAAdaptersPath = "/loras"
+
+	// SuspendReasonManual indicates that the NIMService is suspended by spec.suspend
+	SuspendReasonManual = "Manual"
+	// SuspendReasonIdle indicates that the NIMService is suspended after receiving no requests
+	SuspendReasonIdle = "Idle"
+
+	// NIMServiceWakeAnnotationKey is set by the activator to the time a request was received for a suspended NIMService
+	NIMServiceWakeAnnotationKey = "apps.nvidia.com/wake-requested"
+
+	// TLSCertsPath is the directory the serving certificate is mounted in the NIM container
+	TLSCertsPath = "/opt/nim/tls"
+
+	// TLSSecretHashAnnotationKey is the pod annotation with the hash of the serving certificate, used to rotate the pods on renewal
+	TLSSecretHashAnnotationKey = "apps.nvidia.com/tls-secret-hash"
+
+	// LeaderWorkerSetWorkerIndexLabel is the label set by LeaderWorkerSet with the index of the pod within its group
+	LeaderWorkerSetWorkerIndexLabel = "leaderworkerset.sigs.k8s.io/worker-index"
 )
 
 // NIMServiceSpec defines the desired state of NIMService
@@ -57,6 +168,8 @@ type NIMServiceSpec struct {
 	Command []string        `json:"command,omitempty"`
 	Args    []string        `json:"args,omitempty"`
 	Env     []corev1.EnvVar `json:"env,omitempty"`
+	// Proxy configures the proxy and CA bundle to reach external endpoints, defaults to the operator proxy configuration
+	Proxy *ProxySpec `json:"proxy,omitempty"`
 	// The name of an existing pull secret containing the NGC_API_KEY
 	AuthSecret string `json:"authSecret"`
 	// Storage is the target storage for caching NIM model if NIMCache is not provided
@@ -67,24 +180,188 @@ type NIMServiceSpec struct {
 	Tolerations    []corev1.Toleration          `json:"tolerations,omitempty"`
 	PodAffinity    *corev1.PodAffinity          `json:"podAffinity,omitempty"`
 	Resources      *corev1.ResourceRequirements `json:"resources,omitempty"`
-	Expose         Expose                       `json:"expose,omitempty"`
+	Expose         NIMServiceExpose             `json:"expose,omitempty"`
 	LivenessProbe  Probe                        `json:"livenessProbe,omitempty"`
 	ReadinessProbe Probe                        `json:"readinessProbe,omitempty"`
 	StartupProbe   Probe                        `json:"startupProbe,omitempty"`
-	Scale          Autoscaling                  `json:"scale,omitempty"`
+	Scale          NIMServiceAutoscaling        `json:"scale,omitempty"`
 	Metrics        Metrics                      `json:"metrics,omitempty"`
+	// Affinity is the scheduling affinity of the pods, podAffinity is still honored when affinity.podAffinity is not set
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints describe how the pods are spread across nodes and zones
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PDB limits the number of pods taken down at once by voluntary disruptions such as node drains
+	PDB PodDisruptionBudget `json:"pdb,omitempty"`
+	// NetworkPolicy restricts the pod traffic to the service port and the dependencies of the service
+	NetworkPolicy NetworkPolicy `json:"networkPolicy,omitempty"`
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:default:=1
 	Replicas         int    `json:"replicas,omitempty"`
 	UserID           *int64 `json:"userID,omitempty"`
 	GroupID          *int64 `json:"groupID,omitempty"`
 	RuntimeClassName string `json:"runtimeClassName,omitempty"`
+	// DeploymentKind is the kind of workload to deploy the NIMService with, ignored for multi-node deployments
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet
+	// +kubebuilder:default:=Deployment
+	DeploymentKind string `json:"deploymentKind,omitempty"`
+	// VolumeClaimTemplates are volumes claimed per replica, only used when deploymentKind is StatefulSet
+	VolumeClaimTemplates []VolumeClaimTemplate `json:"volumeClaimTemplates,omitempty"`
+	// MultiNode serves the model across multiple nodes using a LeaderWorkerSet instead of a Deployment
+	MultiNode *NimServiceMultiNodeConfig `json:"multiNode,omitempty"`
+	// Rollout configures progressive rollouts of new revisions, in-place rolling updates are used when not set
+	Rollout *RolloutSpec `json:"rollout,omitempty"`
+	// LoRA configures the LoRA adapters served with the base model
+	LoRA *LoRASpec `json:"lora,omitempty"`
+	// Suspend scales the NIMService to zero replicas while keeping its service endpoint
+	Suspend bool `json:"suspend,omitempty"`
+	// ScaleToZero suspends the NIMService after a period without requests and resumes it on the next request
+	ScaleToZero *ScaleToZeroSpec `json:"scaleToZero,omitempty"`
+	// GPUResourceName is the GPU resource assigned to the NIM, e.g. nvidia.com/mig-3g.40gb for MIG devices
+	// or a time-sliced GPU resource, defaults to the NIMOperatorConfig gpuResourceName, the operator GPU_RESOURCE_NAME or nvidia.com/gpu
+	GPUResourceName corev1.ResourceName `json:"gpuResourceName,omitempty"`
+	// DRA allocates the GPUs with a DRA resource claim instead of the GPU resource
+	DRA *DRAResources `json:"dra,omitempty"`
+}
+
+// ScaleToZeroSpec defines when an idle NIMService is suspended and how it is resumed
+type ScaleToZeroSpec struct {
+	// IdleTimeout is the period without requests after which the NIMService is suspended
+	// +kubebuilder:default:="30m"
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+	// PrometheusURL is the URL of the Prometheus server scraping the NIM metrics
+	PrometheusURL string `json:"prometheusURL"`
+	// RequestMetric is the NIM request counter used to detect activity
+	// +kubebuilder:default:=request_success_total
+	RequestMetric string `json:"requestMetric,omitempty"`
+	// Activator configures the activator serving requests while the NIMService is suspended
+	Activator ActivatorSpec `json:"activator,omitempty"`
+}
+
+// ActivatorSpec defines the activator resuming a suspended NIMService on request
+type ActivatorSpec struct {
+	// Image is the activator container image, defaults to the operator image
+	Image string `json:"image,omitempty"`
+	// PullSecrets to pull the activator image
+	PullSecrets []string `json:"pullSecrets,omitempty"`
+	// WakeTimeout is the maximum time requests are held while the NIMService resumes
+	// +kubebuilder:default:="10m"
+	WakeTimeout *metav1.Duration `json:"wakeTimeout,omitempty"`
+}
+
+// LoRASpec defines the LoRA adapters served by a NIMService
+type LoRASpec struct {
+	// Adapters are the LoRA adapters to serve with the base model
+	Adapters []LoRAAdapter `json:"adapters,omitempty"`
+	// Storage is the volume adapters are downloaded to, required for adapters sourced from NGC or a NemoDatastore
+	Storage PersistentVolumeClaim `json:"storage,omitempty"`
+	// RefreshInterval is the interval in seconds for NIM to check for added or removed adapters
+	// +kubebuilder:validation:Minimum=1
+	RefreshInterval *int32 `json:"refreshInterval,omitempty"`
+}
+
+// LoRAAdapter defines a LoRA adapter and its source
+// +kubebuilder:validation:XValidation:rule="(has(self.ngc) ? 1 : 0) + (has(self.pvc) ? 1 : 0) + (has(self.dataStore) ? 1 : 0) == 1",message="exactly one of ngc, pvc or dataStore must be set"
+type LoRAAdapter struct {
+	// Name of the adapter, used as the model name in inference requests
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=40
+	Name string `json:"name"`
+	// NGC downloads the adapter from the NGC model registry
+	NGC *LoRANGCSource `json:"ngc,omitempty"`
+	// PVC mounts the adapter from an existing PVC
+	PVC *LoRAPVCSource `json:"pvc,omitempty"`
+	// DataStore downloads the adapter from a NemoDatastore model checkpoint
+	DataStore *DataStoreSource `json:"dataStore,omitempty"`
+}
+
+// LoRANGCSource references a LoRA adapter stored on NVIDIA NGC
+type LoRANGCSource struct {
+	// Model is the NGC model version of the adapter, in the form org/[team/]model:version
+	Model string `json:"model"`
+	// AuthSecret is the name of an existing secret containing the NGC_API_KEY, defaults to the NIMService authSecret
+	AuthSecret string `json:"authSecret,omitempty"`
+	// ModelPuller is the container image with the NGC CLI to download the adapter
+	ModelPuller string `json:"modelPuller"`
+	// PullSecret to pull the model puller image
+	PullSecret string `json:"pullSecret,omitempty"`
+}
+
+// LoRAPVCSource references a LoRA adapter stored on an existing PVC
+type LoRAPVCSource struct {
+	// Name is the name of the PVC
+	Name string `json:"name"`
+	// SubPath is the directory of the adapter within the PVC
+	SubPath string `json:"subPath,omitempty"`
+}
+
+// RolloutSpec defines the strategy to roll out new revisions of a NIMService deployment
+type RolloutSpec struct {
+	// Strategy is the rollout strategy for new revisions
+	// +kubebuilder:validation:Enum=Canary;BlueGreen
+	// +kubebuilder:default:=Canary
+	Strategy string `json:"strategy,omitempty"`
+	// Steps are the percentages of traffic shifted to the new revision for canary rollouts, up to 50 as the stable replicas are retained
+	// +kubebuilder:validation:items:Minimum=1
+	// +kubebuilder:validation:items:Maximum=50
+	Steps []int32 `json:"steps,omitempty"`
+	// StepInterval is the time to wait at each canary step before shifting more traffic
+	StepInterval *metav1.Duration `json:"stepInterval,omitempty"`
+	// ProgressDeadline is the time for the new revision to become ready before it is considered failed
+	ProgressDeadline *metav1.Duration `json:"progressDeadline,omitempty"`
+	// SmokeTest is an optional inference request the new revision must serve before it receives traffic
+	SmokeTest *SmokeTest `json:"smokeTest,omitempty"`
+	// AutoRollback removes the new revision when it fails, otherwise it is kept for troubleshooting
+	// +kubebuilder:default:=true
+	AutoRollback *bool `json:"autoRollback,omitempty"`
+}
+
+// SmokeTest defines an HTTP request to validate a new revision
+type SmokeTest struct {
+	// Path is the HTTP path of the request
+	// +kubebuilder:default:=/v1/models
+	Path string `json:"path,omitempty"`
+	// Body is the JSON body of the request, sent as a POST request when set
+	Body string `json:"body,omitempty"`
+	// TimeoutSeconds is the timeout of the request
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=30
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// VolumeClaimTemplate defines a volume claimed per replica of a StatefulSet NIMService
+type VolumeClaimTemplate struct {
+	// Name of the volume, each replica claims a PVC named <name>-<nimservice>-<ordinal>
+	Name string `json:"name"`
+	// MountPath is the path to mount the volume within the NIM container
+	MountPath string `json:"mountPath"`
+	// StorageClass to be used for PVC creation, the cluster default is used when not set
+	StorageClass string `json:"storageClass,omitempty"`
+	// Size of the volume
+	Size string `json:"size"`
+	// VolumeAccessMode is the volume access mode of the PVC
+	// +kubebuilder:default:=ReadWriteOnce
+	VolumeAccessMode corev1.PersistentVolumeAccessMode `json:"volumeAccessMode,omitempty"`
+}
+
+// NimServiceMultiNodeConfig defines the configuration for multi-node NIMService deployments
+type NimServiceMultiNodeConfig struct {
+	// Size is the number of pods in each group, one leader and (size - 1) workers
+	// +kubebuilder:validation:Minimum=2
+	Size int32 `json:"size"`
+	// GPUsPerPod is the number of GPUs requested by each pod in the group, derived from the cached profile when not set
+	// +kubebuilder:validation:Minimum=1
+	GPUsPerPod int32 `json:"gpusPerPod,omitempty"`
 }
 
 // NIMCacheVolSpec defines the spec to use NIMCache volume
 type NIMCacheVolSpec struct {
-	Name    string `json:"name,omitempty"`
+	Name string `json:"name,omitempty"`
+	// Profile is the cached model profile to serve, set to auto to select the best cached profile
+	// for the GPUs available in the cluster
 	Profile string `json:"profile,omitempty"`
+	// RequireVerified mounts the NIMCache only once the checksums of the cached files are verified. The NIMCache must
+	// enable verification, and DataStore sources must set the checksums ConfigMap as they provide none
+	RequireVerified bool `json:"requireVerified,omitempty"`
 }
 
 // NIMServiceStatus defines the observed state of NIMService
@@ -92,6 +369,82 @@ type NIMServiceStatus struct {
 	Conditions        []metav1.Condition `json:"conditions,omitempty"`
 	AvailableReplicas int32              `json:"availableReplicas,omitempty"`
 	State             string             `json:"state,omitempty"`
+	// MultiNode reports the readiness of the leader-worker groups for multi-node deployments
+	MultiNode *MultiNodeStatus `json:"multiNode,omitempty"`
+	// Rollout reports the progress of the rollout of a new revision
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+	// LoRAAdapters reports the state of the LoRA adapters
+	LoRAAdapters []LoRAAdapterStatus `json:"loraAdapters,omitempty"`
+	// Suspension reports whether the NIMService is scaled to zero
+	Suspension *SuspensionStatus `json:"suspension,omitempty"`
+	// Profile reports the cached profile selected when the profile is set to auto
+	Profile *ProfileSelectionStatus `json:"profile,omitempty"`
+}
+
+// ProfileSelectionStatus defines the automatically selected model profile of a NIMService
+type ProfileSelectionStatus struct {
+	// Name of the selected profile
+	Name string `json:"name"`
+	// GPU is the GPU product the selected profile runs on
+	GPU string `json:"gpu,omitempty"`
+	// Reason describes why the profile was selected
+	Reason string `json:"reason,omitempty"`
+}
+
+// SuspensionStatus defines the observed suspension state of a NIMService
+type SuspensionStatus struct {
+	// Suspended indicates that the NIMService is scaled to zero
+	Suspended bool `json:"suspended"`
+	// Reason is the reason of the suspension, one of Manual or Idle
+	Reason string `json:"reason,omitempty"`
+	// SuspendedAt is the time the NIMService was suspended
+	SuspendedAt *metav1.Time `json:"suspendedAt,omitempty"`
+	// LastActivityTime is the last time requests were observed or the NIMService was resumed
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty"`
+}
+
+// LoRAAdapterStatus defines the observed state of a LoRA adapter
+type LoRAAdapterStatus struct {
+	// Name of the adapter
+	Name string `json:"name"`
+	// State of the adapter, one of Pending, Downloading, Ready or Failed
+	State string `json:"state"`
+	// Message is a human readable message about the adapter state
+	Message string `json:"message,omitempty"`
+}
+
+// RolloutStatus defines the observed state of a NIMService rollout
+type RolloutStatus struct {
+	// Phase is the phase of the rollout
+	Phase string `json:"phase,omitempty"`
+	// StableRevision is the revision of the stable deployment
+	StableRevision string `json:"stableRevision,omitempty"`
+	// CandidateRevision is the revision being rolled out
+	CandidateRevision string `json:"candidateRevision,omitempty"`
+	// FailedRevision is the last revision that failed, it is not retried until the spec changes
+	FailedRevision string `json:"failedRevision,omitempty"`
+	// Traffic is the revision serving traffic, one of Stable, Split or Candidate
+	Traffic string `json:"traffic,omitempty"`
+	// CanaryWeight is the percentage of traffic shifted to the candidate revision
+	CanaryWeight int32 `json:"canaryWeight,omitempty"`
+	// CurrentStep is the index of the current canary step
+	CurrentStep int32 `json:"currentStep,omitempty"`
+	// SmokeTestPassed indicates that the candidate revision passed the smoke test
+	SmokeTestPassed bool `json:"smokeTestPassed,omitempty"`
+	// StepStartTime is the time the current step or phase started
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+	// Message is a human readable message about the rollout
+	Message string `json:"message,omitempty"`
+}
+
+// MultiNodeStatus defines the observed state of the leader-worker groups of a multi-node NIMService
+type MultiNodeStatus struct {
+	// Groups is the number of leader-worker groups
+	Groups int32 `json:"groups,omitempty"`
+	// ReadyGroups is the number of groups with all pods ready
+	ReadyGroups int32 `json:"readyGroups,omitempty"`
+	// Size is the number of pods in each group
+	Size int32 `json:"size,omitempty"`
 }
 
 // +genclient
@@ -118,6 +471,28 @@ type NIMServiceList struct {
 	Items           []NIMService `json:"items"`
 }
 
+// NIMServiceExpose defines attributes to expose the NIM service
+type NIMServiceExpose struct {
+	Expose `json:",inline"`
+	// TLS serves the service over TLS end to end
+	TLS TLS `json:"tls,omitempty"`
+}
+
+// NIMServiceAutoscaling defines attributes to automatically scale the NIM service based on metrics
+// +kubebuilder:validation:XValidation:rule="!(has(self.keda) && has(self.hpa) && self.hpa.maxReplicas > 0)", message="hpa and keda are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.keda) && has(self.presets))", message="presets are only supported with hpa"
+type NIMServiceAutoscaling struct {
+	Autoscaling `json:",inline"`
+	// KEDA scales the service with a KEDA ScaledObject instead of an HPA
+	KEDA *KEDASpec `json:"keda,omitempty"`
+	// Presets are NIM inference metrics added to the HPA metrics
+	// +listType=map
+	// +listMapKey=name
+	Presets []AutoscalingPreset `json:"presets,omitempty"`
+	// PrometheusAdapter adds the rules serving the preset metrics through the custom metrics API to the prometheus-adapter configuration
+	PrometheusAdapter PrometheusAdapter `json:"prometheusAdapter,omitempty"`
+}
+
 // NIMServiceStorage defines the attributes of various storage targets used to store the model
 type NIMServiceStorage struct {
 	NIMCache NIMCacheVolSpec `json:"nimCache,omitempty"`
@@ -247,6 +622,26 @@ func (n *NIMService) GetPodAffinity() *corev1.PodAffinity {
 	return n.Spec.PodAffinity
 }
 
+// GetAffinity returns the affinity for the NIMService instance, including the pod affinity set with podAffinity
+func (n *NIMService) GetAffinity() *corev1.Affinity {
+	if n.Spec.Affinity == nil && n.Spec.PodAffinity == nil {
+		return nil
+	}
+	affinity := &corev1.Affinity{}
+	if n.Spec.Affinity != nil {
+		affinity = n.Spec.Affinity.DeepCopy()
+	}
+	if affinity.PodAffinity == nil && n.Spec.PodAffinity != nil {
+		affinity.PodAffinity = n.Spec.PodAffinity.DeepCopy()
+	}
+	return affinity
+}
+
+// GetTopologySpreadConstraints returns the topology spread constraints for the NIMService instance
+func (n *NIMService) GetTopologySpreadConstraints() []corev1.TopologySpreadConstraint {
+	return n.Spec.TopologySpreadConstraints
+}
+
 // GetContainerName returns name of the container for NIMService deployment
 func (n *NIMService) GetContainerName() string {
 	return fmt.Sprintf("%s-ctr", n.Name)
@@ -264,7 +659,291 @@ func (n *NIMService) GetArgs() []string {
 
 // GetEnv returns merged slice of standard and user specified env variables
 func (n *NIMService) GetEnv() []corev1.EnvVar {
-	return utils.MergeEnvVars(n.GetStandardEnv(), n.Spec.Env)
+	env := n.GetStandardEnv()
+	if n.IsLoRAEnabled() {
+		env = append(env, n.GetLoRAEnv()...)
+	}
+	if n.IsTLSEnabled() {
+		env = append(env, n.GetTLSEnv()...)
+	}
+	env = append(env, GetProxy(n.Spec.Proxy).GetEnv()...)
+	return utils.MergeEnvVars(env, n.Spec.Env)
+}
+
+// IsScaleToZeroEnabled returns true if the NIMService is suspended when idle
+func (n *NIMService) IsScaleToZeroEnabled() bool {
+	return n.Spec.ScaleToZero != nil
+}
+
+// IsSuspended returns true if the NIMService is scaled to zero
+func (n *NIMService) IsSuspended() bool {
+	return n.Spec.Suspend || (n.Status.Suspension != nil && n.Status.Suspension.Suspended)
+}
+
+// IsIdleSuspended returns true if the NIMService is scaled to zero after receiving no requests
+func (n *NIMService) IsIdleSuspended() bool {
+	return n.IsScaleToZeroEnabled() && !n.Spec.Suspend && n.Status.Suspension != nil &&
+		n.Status.Suspension.Suspended && n.Status.Suspension.Reason == SuspendReasonIdle
+}
+
+// GetIdleTimeout returns the period without requests after which the NIMService is suspended, defaults to 30 minutes
+func (n *NIMService) GetIdleTimeout() time.Duration {
+	if n.Spec.ScaleToZero == nil || n.Spec.ScaleToZero.IdleTimeout == nil {
+		return 30 * time.Minute
+	}
+	return n.Spec.ScaleToZero.IdleTimeout.Duration
+}
+
+// GetRequestMetric returns the NIM request counter used to detect activity
+func (n *NIMService) GetRequestMetric() string {
+	if n.Spec.ScaleToZero == nil || n.Spec.ScaleToZero.RequestMetric == "" {
+		return "request_success_total"
+	}
+	return n.Spec.ScaleToZero.RequestMetric
+}
+
+// GetWakeTimeout returns the maximum time the activator holds requests while the NIMService resumes, defaults to 10 minutes
+func (n *NIMService) GetWakeTimeout() time.Duration {
+	if n.Spec.ScaleToZero == nil || n.Spec.ScaleToZero.Activator.WakeTimeout == nil {
+		return 10 * time.Minute
+	}
+	return n.Spec.ScaleToZero.Activator.WakeTimeout.Duration
+}
+
+// GetActivatorName returns the name of the activator resources of the NIMService
+func (n *NIMService) GetActivatorName() string {
+	return fmt.Sprintf("%s-activator", n.GetName())
+}
+
+// IsLoRAEnabled returns true if LoRA adapters are served with the base model
+func (n *NIMService) IsLoRAEnabled() bool {
+	return n.Spec.LoRA != nil && len(n.Spec.LoRA.Adapters) > 0
+}
+
+// IsLoRAStorageRequired returns true if any LoRA adapter is downloaded to the adapter storage
+func (n *NIMService) IsLoRAStorageRequired() bool {
+	if !n.IsLoRAEnabled() {
+		return false
+	}
+	for _, adapter := range n.Spec.LoRA.Adapters {
+		if adapter.PVC == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLoRAPVCName returns the name of the PVC LoRA adapters are downloaded to
+func (n *NIMService) GetLoRAPVCName() string {
+	if n.Spec.LoRA != nil && n.Spec.LoRA.Storage.Name != "" {
+		return n.Spec.LoRA.Storage.Name
+	}
+	return fmt.Sprintf("%s-lora-pvc", n.GetName())
+}
+
+// GetLoRAAdapterJobName returns the name of the job downloading the given LoRA adapter
+func (n *NIMService) GetLoRAAdapterJobName(adapter string) string {
+	return fmt.Sprintf("%s-lora-%s", n.GetName(), adapter)
+}
+
+// GetLoRAEnv returns the env variables for NIM to load the LoRA adapters
+func (n *NIMService) GetLoRAEnv() []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{
+			Name:  "NIM_PEFT_SOURCE",
+			Value: LoRAAdaptersPath,
+		},
+	}
+	if n.Spec.LoRA.RefreshInterval != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "NIM_PEFT_REFRESH_INTERVAL",
+			Value: fmt.Sprint(*n.Spec.LoRA.RefreshInterval),
+		})
+	}
+	return env
+}
+
+// GetLoRAVolumes returns the volumes with the LoRA adapters
+func (n *NIMService) GetLoRAVolumes() []corev1.Volume {
+	if !n.IsLoRAEnabled() {
+		return nil
+	}
+	var volumes []corev1.Volume
+	if n.IsLoRAStorageRequired() {
+		volumes = append(volumes, corev1.Volume{
+			Name: "lora-store",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: n.GetLoRAPVCName(),
+				},
+			},
+		})
+	}
+	for _, adapter := range n.Spec.LoRA.Adapters {
+		if adapter.PVC == nil {
+			continue
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: fmt.Sprintf("lora-%s", adapter.Name),
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: adapter.PVC.Name,
+					ReadOnly:  true,
+				},
+			},
+		})
+	}
+	return volumes
+}
+
+// GetLoRAVolumeMounts returns the volume mounts for NIM to load the LoRA adapters
+func (n *NIMService) GetLoRAVolumeMounts() []corev1.VolumeMount {
+	if !n.IsLoRAEnabled() {
+		return nil
+	}
+	var volumeMounts []corev1.VolumeMount
+	if n.IsLoRAStorageRequired() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "lora-store",
+			MountPath: LoRAAdaptersPath,
+			SubPath:   n.Spec.LoRA.Storage.SubPath,
+		})
+	}
+	// Adapters on existing PVCs are mounted directly within the adapters directory
+	for _, adapter := range n.Spec.LoRA.Adapters {
+		if adapter.PVC == nil {
+			continue
+		}
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      fmt.Sprintf("lora-%s", adapter.Name),
+			MountPath: fmt.Sprintf("%s/%s", LoRAAdaptersPath, adapter.Name),
+			SubPath:   adapter.PVC.SubPath,
+			ReadOnly:  true,
+		})
+	}
+	return volumeMounts
+}
+
+// IsTLSEnabled returns true if the NIMService is served over TLS
+func (n *NIMService) IsTLSEnabled() bool {
+	return n.Spec.Expose.TLS.Enabled != nil && *n.Spec.Expose.TLS.Enabled
+}
+
+// IsCertManagerEnabled returns true if the serving certificate is requested from cert-manager
+func (n *NIMService) IsCertManagerEnabled() bool {
+	return n.IsTLSEnabled() && n.Spec.Expose.TLS.CertManager != nil
+}
+
+// GetTLSSecretName returns the name of the secret with the serving certificate
+func (n *NIMService) GetTLSSecretName() string {
+	if n.Spec.Expose.TLS.SecretName != "" {
+		return n.Spec.Expose.TLS.SecretName
+	}
+	return fmt.Sprintf("%s-tls", n.GetName())
+}
+
+// GetTLSDNSNames returns the DNS names of the serving certificate, the in-cluster service names and the ingress hosts
+func (n *NIMService) GetTLSDNSNames() []string {
+	dnsNames := []string{
+		n.GetName(),
+		fmt.Sprintf("%s.%s", n.GetName(), n.GetNamespace()),
+		fmt.Sprintf("%s.%s.svc", n.GetName(), n.GetNamespace()),
+		fmt.Sprintf("%s.%s.svc.cluster.local", n.GetName(), n.GetNamespace()),
+	}
+	if n.IsIngressEnabled() {
+		for _, rule := range n.Spec.Expose.Ingress.Spec.Rules {
+			if rule.Host != "" {
+				dnsNames = append(dnsNames, rule.Host)
+			}
+		}
+	}
+	if n.Spec.Expose.TLS.CertManager != nil {
+		dnsNames = append(dnsNames, n.Spec.Expose.TLS.CertManager.DNSNames...)
+	}
+
+	var unique []string
+	seen := map[string]bool{}
+	for _, name := range dnsNames {
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	return unique
+}
+
+// GetTLSEnv returns the env variables for NIM to serve over TLS
+func (n *NIMService) GetTLSEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name:  "NIM_SSL_MODE",
+			Value: "TLS",
+		},
+		{
+			Name:  "NIM_SSL_KEY_PATH",
+			Value: fmt.Sprintf("%s/%s", TLSCertsPath, corev1.TLSPrivateKeyKey),
+		},
+		{
+			Name:  "NIM_SSL_CERTS_PATH",
+			Value: fmt.Sprintf("%s/%s", TLSCertsPath, corev1.TLSCertKey),
+		},
+	}
+}
+
+// GetTLSVolumes returns the volume with the serving certificate
+func (n *NIMService) GetTLSVolumes() []corev1.Volume {
+	if !n.IsTLSEnabled() {
+		return nil
+	}
+	return []corev1.Volume{
+		{
+			Name: "tls",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: n.GetTLSSecretName(),
+				},
+			},
+		},
+	}
+}
+
+// GetTLSVolumeMounts returns the volume mount for NIM to load the serving certificate
+func (n *NIMService) GetTLSVolumeMounts() []corev1.VolumeMount {
+	if !n.IsTLSEnabled() {
+		return nil
+	}
+	return []corev1.VolumeMount{
+		{
+			Name:      "tls",
+			MountPath: TLSCertsPath,
+			ReadOnly:  true,
+		},
+	}
+}
+
+// GetCertificateParams returns params to render a cert-manager Certificate from templates
+func (n *NIMService) GetCertificateParams() *rendertypes.CertificateParams {
+	params := &rendertypes.CertificateParams{}
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNIMServiceAnnotations()
+
+	params.SecretName = n.GetTLSSecretName()
+	params.DNSNames = n.GetTLSDNSNames()
+	if certManager := n.Spec.Expose.TLS.CertManager; certManager != nil {
+		params.IssuerName = certManager.IssuerRef.Name
+		params.IssuerKind = certManager.IssuerRef.Kind
+		if certManager.Duration != nil {
+			params.Duration = certManager.Duration.Duration.String()
+		}
+		if certManager.RenewBefore != nil {
+			params.RenewBefore = certManager.RenewBefore.Duration.String()
+		}
+	}
+	return params
 }
 
 // GetImage returns container image for the NIMService
@@ -287,6 +966,35 @@ func (n *NIMService) GetResources() *corev1.ResourceRequirements {
 	return n.Spec.Resources
 }
 
+// GetGPUResourceName returns the GPU resource to assign to the NIMService container
+func (n *NIMService) GetGPUResourceName() corev1.ResourceName {
+	if n.Spec.GPUResourceName != "" {
+		return n.Spec.GPUResourceName
+	}
+	if name := os.Getenv(GPUResourceNameEnv); name != "" {
+		return corev1.ResourceName(name)
+	}
+	return DefaultGPUResourceName
+}
+
+// IsDRAEnabled returns true if the GPUs of the NIMService are allocated with a DRA resource claim
+func (n *NIMService) IsDRAEnabled() bool {
+	return n.Spec.DRA != nil
+}
+
+// GetResourceClaimTemplateName returns the name of the ResourceClaimTemplate of the NIMService GPUs
+func (n *NIMService) GetResourceClaimTemplateName() string {
+	if n.Spec.DRA != nil && n.Spec.DRA.ResourceClaimTemplateName != "" {
+		return n.Spec.DRA.ResourceClaimTemplateName
+	}
+	return fmt.Sprintf("%s-gpu", n.GetName())
+}
+
+// IsMIGResource returns true if the NIMService is assigned MIG devices, which only provide a single device per container
+func (n *NIMService) IsMIGResource() bool {
+	return strings.HasPrefix(string(n.GetGPUResourceName()), MIGResourcePrefix)
+}
+
 // IsProbeEnabled returns true if a given liveness/readiness/startup probe is enabled
 func IsProbeEnabled(probe Probe) bool {
 	if probe.Enabled == nil {
@@ -322,6 +1030,10 @@ func (n *NIMService) GetDefaultLivenessProbe() *corev1.Probe {
 		},
 	}
 
+	if n.IsTLSEnabled() {
+		probe.HTTPGet.Scheme = corev1.URISchemeHTTPS
+	}
+
 	return &probe
 }
 
@@ -352,6 +1064,10 @@ func (n *NIMService) GetDefaultReadinessProbe() *corev1.Probe {
 		},
 	}
 
+	if n.IsTLSEnabled() {
+		probe.HTTPGet.Scheme = corev1.URISchemeHTTPS
+	}
+
 	return &probe
 }
 
@@ -382,6 +1098,10 @@ func (n *NIMService) GetDefaultStartupProbe() *corev1.Probe {
 		},
 	}
 
+	if n.IsTLSEnabled() {
+		probe.HTTPGet.Scheme = corev1.URISchemeHTTPS
+	}
+
 	return &probe
 }
 
@@ -413,6 +1133,7 @@ func (n *NIMService) GetVolumes(modelPVC PersistentVolumeClaim) []corev1.Volume
 			},
 		},
 	}
+	volumes = append(volumes, GetProxy(n.Spec.Proxy).GetVolumes()...)
 
 	return volumes
 }
@@ -430,6 +1151,7 @@ func (n *NIMService) GetVolumeMounts(modelPVC PersistentVolumeClaim) []corev1.Vo
 			MountPath: "/dev/shm",
 		},
 	}
+	volumeMounts = append(volumeMounts, GetProxy(n.Spec.Proxy).GetVolumeMounts()...)
 
 	return volumeMounts
 }
@@ -454,6 +1176,16 @@ func (n *NIMService) GetNIMCacheProfile() string {
 	return n.Spec.Storage.NIMCache.Profile
 }
 
+// IsNIMCacheVerificationRequired returns true if the NIMCache is mounted only once its cached files are verified
+func (n *NIMService) IsNIMCacheVerificationRequired() bool {
+	return n.Spec.Storage.NIMCache.RequireVerified
+}
+
+// IsAutoProfileSelectionEnabled returns true if the cached profile to serve is selected by the operator
+func (n *NIMService) IsAutoProfileSelectionEnabled() bool {
+	return n.GetNIMCacheName() != "" && n.GetNIMCacheProfile() == AutoProfile
+}
+
 // GetHPA returns the HPA spec for the NIMService deployment
 func (n *NIMService) GetHPA() HorizontalPodAutoscalerSpec {
 	return n.Spec.Scale.HPA
@@ -472,9 +1204,147 @@ func (n *NIMService) GetReplicas() int {
 	return n.Spec.Replicas
 }
 
+// GetKServeModelFormat returns the model format used to bind the KServe InferenceService to its ServingRuntime
+func (n *NIMService) GetKServeModelFormat() string {
+	return fmt.Sprintf("nvidia-nim-%s", n.GetName())
+}
+
 // GetDeploymentKind returns the kind of deployment for NIMService
 func (n *NIMService) GetDeploymentKind() string {
-	return "Deployment"
+	if n.IsMultiNodeEnabled() {
+		return "LeaderWorkerSet"
+	}
+	if n.Spec.DeploymentKind == DeploymentKindStatefulSet {
+		return DeploymentKindStatefulSet
+	}
+	return DeploymentKindDeployment
+}
+
+// GetDeploymentAPIVersion returns the API version of the deployment for NIMService
+func (n *NIMService) GetDeploymentAPIVersion() string {
+	if n.IsMultiNodeEnabled() {
+		return "leaderworkerset.x-k8s.io/v1"
+	}
+	return "apps/v1"
+}
+
+// IsRolloutEnabled returns true if new revisions of the NIMService are rolled out progressively
+func (n *NIMService) IsRolloutEnabled() bool {
+	return n.Spec.Rollout != nil
+}
+
+// GetRolloutStrategy returns the rollout strategy for new revisions
+func (n *NIMService) GetRolloutStrategy() string {
+	if n.Spec.Rollout == nil || n.Spec.Rollout.Strategy == "" {
+		return RolloutStrategyCanary
+	}
+	return n.Spec.Rollout.Strategy
+}
+
+// GetRolloutSteps returns the canary traffic weights, defaults to 10% and 50%
+func (n *NIMService) GetRolloutSteps() []int32 {
+	if n.Spec.Rollout == nil || len(n.Spec.Rollout.Steps) == 0 {
+		return []int32{10, 50}
+	}
+	return n.Spec.Rollout.Steps
+}
+
+// GetRolloutStepInterval returns the time to wait at each canary step, defaults to 5 minutes
+func (n *NIMService) GetRolloutStepInterval() time.Duration {
+	if n.Spec.Rollout == nil || n.Spec.Rollout.StepInterval == nil {
+		return 5 * time.Minute
+	}
+	return n.Spec.Rollout.StepInterval.Duration
+}
+
+// GetRolloutProgressDeadline returns the time for a new revision to become ready, defaults to 30 minutes
+func (n *NIMService) GetRolloutProgressDeadline() time.Duration {
+	if n.Spec.Rollout == nil || n.Spec.Rollout.ProgressDeadline == nil {
+		return 30 * time.Minute
+	}
+	return n.Spec.Rollout.ProgressDeadline.Duration
+}
+
+// IsRolloutAutoRollbackEnabled returns true if failed revisions are rolled back automatically
+func (n *NIMService) IsRolloutAutoRollbackEnabled() bool {
+	return n.Spec.Rollout == nil || n.Spec.Rollout.AutoRollback == nil || *n.Spec.Rollout.AutoRollback
+}
+
+// GetCandidateName returns the name of the deployment and service for the candidate revision
+func (n *NIMService) GetCandidateName() string {
+	return fmt.Sprintf("%s-candidate", n.GetName())
+}
+
+// GetPodNameRegex returns the regular expression matching the names of the NIMService pods, anchored to the name
+// suffix of their controller so that the pods of NIMServices sharing the name as a prefix are not matched
+func (n *NIMService) GetPodNameRegex() string {
+	name := regexp.QuoteMeta(n.GetName())
+	if n.IsMultiNodeEnabled() {
+		// Leader pods are suffixed with the group index, worker pods with the group and worker indexes
+		return fmt.Sprintf("%s-[0-9]+(-[0-9]+)?", name)
+	}
+	if n.GetDeploymentKind() == DeploymentKindStatefulSet {
+		return fmt.Sprintf("%s-[0-9]+", name)
+	}
+	// Pods of both revisions are suffixed with the pod template hash and a random suffix
+	return fmt.Sprintf("%s(-candidate)?-[a-z0-9]{1,10}-[a-z0-9]{5}", name)
+}
+
+// GetRolloutTraffic returns the revision serving traffic
+func (n *NIMService) GetRolloutTraffic() string {
+	if n.Status.Rollout == nil || n.Status.Rollout.Traffic == "" {
+		return RolloutTrafficStable
+	}
+	return n.Status.Rollout.Traffic
+}
+
+// IsMultiNodeEnabled returns true if the NIMService is deployed across multiple nodes
+func (n *NIMService) IsMultiNodeEnabled() bool {
+	return n.Spec.MultiNode != nil
+}
+
+// GetMultiNodeSize returns the number of pods in each leader-worker group
+func (n *NIMService) GetMultiNodeSize() int32 {
+	if !n.IsMultiNodeEnabled() {
+		return 1
+	}
+	return n.Spec.MultiNode.Size
+}
+
+// GetMultiNodeEnv returns the env variables to coordinate the leader or worker pods of a multi-node NIMService
+func (n *NIMService) GetMultiNodeEnv(leader bool) []corev1.EnvVar {
+	leaderRole := "0"
+	if leader {
+		leaderRole = "1"
+	}
+	multiNodeEnv := []corev1.EnvVar{
+		{
+			Name:  "NIM_MULTI_NODE",
+			Value: "1",
+		},
+		{
+			Name:  "NIM_NUM_COMPUTE_NODES",
+			Value: fmt.Sprint(n.GetMultiNodeSize()),
+		},
+		{
+			Name:  "NIM_LEADER_ROLE",
+			Value: leaderRole,
+		},
+		{
+			// LWS_LEADER_ADDRESS is injected by the LeaderWorkerSet controller ahead of the container env
+			Name:  "NIM_LEADER_ADDRESS",
+			Value: "$(LWS_LEADER_ADDRESS)",
+		},
+		{
+			Name: "NIM_NODE_RANK",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: fmt.Sprintf("metadata.labels['%s']", LeaderWorkerSetWorkerIndexLabel),
+				},
+			},
+		},
+	}
+	return multiNodeEnv
 }
 
 // IsAutoScalingEnabled returns true if autoscaling is enabled for NIMService deployment
@@ -482,6 +1352,11 @@ func (n *NIMService) IsAutoScalingEnabled() bool {
 	return n.Spec.Scale.Enabled != nil && *n.Spec.Scale.Enabled
 }
 
+// IsKEDAEnabled returns true if the NIMService is scaled by a KEDA ScaledObject instead of an HPA
+func (n *NIMService) IsKEDAEnabled() bool {
+	return n.IsAutoScalingEnabled() && n.Spec.Scale.KEDA != nil
+}
+
 // IsIngressEnabled returns true if ingress is enabled for NIMService deployment
 func (n *NIMService) IsIngressEnabled() bool {
 	return n.Spec.Expose.Ingress.Enabled != nil && *n.Spec.Expose.Ingress.Enabled
@@ -489,7 +1364,18 @@ func (n *NIMService) IsIngressEnabled() bool {
 
 // GetIngressSpec returns the Ingress spec NIMService deployment
 func (n *NIMService) GetIngressSpec() networkingv1.IngressSpec {
-	return n.Spec.Expose.Ingress.Spec
+	spec := *n.Spec.Expose.Ingress.Spec.DeepCopy()
+	// Terminate TLS at the ingress with the serving certificate unless configured explicitly
+	if n.IsTLSEnabled() && len(spec.TLS) == 0 {
+		var hosts []string
+		for _, rule := range spec.Rules {
+			if rule.Host != "" {
+				hosts = append(hosts, rule.Host)
+			}
+		}
+		spec.TLS = []networkingv1.IngressTLS{{Hosts: hosts, SecretName: n.GetTLSSecretName()}}
+	}
+	return spec
 }
 
 // IsServiceMonitorEnabled returns true if servicemonitor is enabled for NIMService deployment
@@ -554,7 +1440,8 @@ func (n *NIMService) GetDeploymentParams() *rendertypes.DeploymentParams {
 	}
 	params.NodeSelector = n.GetNodeSelector()
 	params.Tolerations = n.GetTolerations()
-	params.Affinity = n.GetPodAffinity()
+	params.Affinity = n.GetAffinity()
+	params.TopologySpreadConstraints = n.GetTopologySpreadConstraints()
 	params.ImagePullSecrets = n.GetImagePullSecrets()
 	params.ImagePullPolicy = n.GetImagePullPolicy()
 
@@ -609,7 +1496,8 @@ func (n *NIMService) GetStatefulSetParams() *rendertypes.StatefulSetParams {
 	params.ServiceName = n.GetName()
 	params.NodeSelector = n.GetNodeSelector()
 	params.Tolerations = n.GetTolerations()
-	params.Affinity = n.GetPodAffinity()
+	params.Affinity = n.GetAffinity()
+	params.TopologySpreadConstraints = n.GetTopologySpreadConstraints()
 	params.ImagePullSecrets = n.GetImagePullSecrets()
 	params.ImagePullPolicy = n.GetImagePullPolicy()
 
@@ -625,9 +1513,162 @@ func (n *NIMService) GetStatefulSetParams() *rendertypes.StatefulSetParams {
 	params.Image = n.GetImage()
 
 	// Set container probes
-	params.LivenessProbe = n.GetLivenessProbe()
-	params.ReadinessProbe = n.GetReadinessProbe()
-	params.StartupProbe = n.GetStartupProbe()
+	if IsProbeEnabled(n.Spec.LivenessProbe) {
+		params.LivenessProbe = n.GetLivenessProbe()
+	}
+	if IsProbeEnabled(n.Spec.ReadinessProbe) {
+		params.ReadinessProbe = n.GetReadinessProbe()
+	}
+	if IsProbeEnabled(n.Spec.StartupProbe) {
+		params.StartupProbe = n.GetStartupProbe()
+	}
+	params.UserID = n.GetUserID()
+	params.GroupID = n.GetGroupID()
+
+	// Set service account
+	params.ServiceAccountName = n.GetServiceAccountName()
+
+	// Set runtime class
+	params.RuntimeClassName = n.GetRuntimeClassName()
+	return params
+}
+
+// GetLeaderWorkerSetParams returns params to render LeaderWorkerSet from templates
+func (n *NIMService) GetLeaderWorkerSetParams() *rendertypes.LeaderWorkerSetParams {
+	params := &rendertypes.LeaderWorkerSetParams{}
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNIMServiceAnnotations()
+
+	// Set group spec, replicas are managed by the HPA when autoscaling is enabled
+	if !n.IsAutoScalingEnabled() {
+		params.Replicas = n.GetReplicas()
+	}
+	params.Size = n.GetMultiNodeSize()
+
+	// Set template spec
+	params.NodeSelector = n.GetNodeSelector()
+	params.Tolerations = n.GetTolerations()
+	params.Affinity = n.GetAffinity()
+	params.TopologySpreadConstraints = n.GetTopologySpreadConstraints()
+	params.ImagePullSecrets = n.GetImagePullSecrets()
+	params.ImagePullPolicy = n.GetImagePullPolicy()
+
+	// Set labels and selectors
+	params.SelectorLabels = n.GetSelectorLabels()
+
+	// Set container spec
+	params.ContainerName = n.GetContainerName()
+	params.LeaderEnv = utils.MergeEnvVars(n.GetEnv(), n.GetMultiNodeEnv(true))
+	params.WorkerEnv = utils.MergeEnvVars(n.GetEnv(), n.GetMultiNodeEnv(false))
+	params.Args = n.GetArgs()
+	params.Command = n.GetCommand()
+	params.Resources = n.GetResources()
+	params.Image = n.GetImage()
+	params.Port = n.GetServicePort()
+
+	// Set container probes, only the leader serves the API endpoints
+	if IsProbeEnabled(n.Spec.LivenessProbe) {
+		params.LivenessProbe = n.GetLivenessProbe()
+	}
+	if IsProbeEnabled(n.Spec.ReadinessProbe) {
+		params.ReadinessProbe = n.GetReadinessProbe()
+	}
+	if IsProbeEnabled(n.Spec.StartupProbe) {
+		params.StartupProbe = n.GetStartupProbe()
+	}
+	params.UserID = n.GetUserID()
+	params.GroupID = n.GetGroupID()
+
+	// Set service account
+	params.ServiceAccountName = n.GetServiceAccountName()
+
+	// Set runtime class
+	params.RuntimeClassName = n.GetRuntimeClassName()
+	return params
+}
+
+// GetServingRuntimeParams returns params to render KServe ServingRuntime from templates
+func (n *NIMService) GetServingRuntimeParams() *rendertypes.ServingRuntimeParams {
+	params := &rendertypes.ServingRuntimeParams{}
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNIMServiceAnnotations()
+
+	// Set supported model format, unique per NIMService instance
+	params.ModelFormat = n.GetKServeModelFormat()
+
+	// Set template spec
+	params.NodeSelector = n.GetNodeSelector()
+	params.Tolerations = n.GetTolerations()
+	params.ImagePullSecrets = n.GetImagePullSecrets()
+	params.ImagePullPolicy = n.GetImagePullPolicy()
+
+	// Set container spec, KServe merges the predictor model container into the one named kserve-container
+	params.ContainerName = KServeContainerName
+	params.Env = n.GetEnv()
+	params.Args = n.GetArgs()
+	params.Command = n.GetCommand()
+	params.Image = n.GetImage()
+	params.Port = n.GetServicePort()
+
+	// Set container probes
+	if IsProbeEnabled(n.Spec.LivenessProbe) {
+		params.LivenessProbe = n.GetLivenessProbe()
+	}
+	if IsProbeEnabled(n.Spec.ReadinessProbe) {
+		params.ReadinessProbe = n.GetReadinessProbe()
+	}
+	if IsProbeEnabled(n.Spec.StartupProbe) {
+		params.StartupProbe = n.GetStartupProbe()
+	}
+	return params
+}
+
+// GetInferenceServiceParams returns params to render KServe InferenceService from templates
+func (n *NIMService) GetInferenceServiceParams() *rendertypes.InferenceServiceParams {
+	params := &rendertypes.InferenceServiceParams{}
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNIMServiceAnnotations()
+
+	// Reference the ServingRuntime rendered for this NIMService
+	params.RuntimeName = n.GetName()
+	params.ModelFormat = n.GetKServeModelFormat()
+
+	// Set replicas, KServe manages the autoscaler for the predictor
+	if n.IsAutoScalingEnabled() {
+		hpa := n.GetHPA()
+		params.MinReplicas = 1
+		if hpa.MinReplicas != nil {
+			params.MinReplicas = *hpa.MinReplicas
+		}
+		params.MaxReplicas = hpa.MaxReplicas
+		// KServe supports a single resource utilization target
+		for _, metric := range hpa.Metrics {
+			if metric.Type == autoscalingv2.ResourceMetricSourceType && metric.Resource != nil && metric.Resource.Target.AverageUtilization != nil {
+				params.ScaleMetric = string(metric.Resource.Name)
+				params.ScaleTarget = *metric.Resource.Target.AverageUtilization
+				break
+			}
+		}
+	} else {
+		params.MinReplicas = int32(n.GetReplicas())
+		params.MaxReplicas = int32(n.GetReplicas())
+	}
+
+	params.Resources = n.GetResources()
+	params.UserID = n.GetUserID()
+	params.GroupID = n.GetGroupID()
 
 	// Set service account
 	params.ServiceAccountName = n.GetServiceAccountName()
@@ -649,6 +1690,23 @@ func (n *NIMService) GetServiceParams() *rendertypes.ServiceParams {
 
 	// Set service selector labels
 	params.SelectorLabels = n.GetSelectorLabels()
+	if n.IsMultiNodeEnabled() {
+		// Only the leader of each group serves requests
+		params.SelectorLabels = utils.MergeMaps(params.SelectorLabels, map[string]string{LeaderWorkerSetWorkerIndexLabel: "0"})
+	}
+	if n.IsRolloutEnabled() {
+		switch n.GetRolloutTraffic() {
+		case RolloutTrafficSplit:
+			// Select pods of both revisions, traffic is split proportionally to their replicas
+			params.SelectorLabels = map[string]string{"app.kubernetes.io/instance": n.GetName()}
+		case RolloutTrafficCandidate:
+			params.SelectorLabels = map[string]string{"app": n.GetCandidateName()}
+		}
+	}
+	if n.IsIdleSuspended() {
+		// Requests are served by the activator to resume the NIMService
+		params.SelectorLabels = map[string]string{"app": n.GetActivatorName()}
+	}
 
 	// Set service type
 	params.Type = n.GetServiceType()
@@ -656,6 +1714,9 @@ func (n *NIMService) GetServiceParams() *rendertypes.ServiceParams {
 	// Set service ports
 	params.Port = n.GetServicePort()
 	params.PortName = "service-port"
+	if n.Spec.Expose.GatewayRoute.IsGRPCEnabled() {
+		params.GRPCPort = n.Spec.Expose.GatewayRoute.GetGRPCPort()
+	}
 	return params
 }
 
@@ -673,6 +1734,23 @@ func (n *NIMService) GetIngressParams() *rendertypes.IngressParams {
 	return params
 }
 
+// IsGatewayRouteEnabled returns true if Gateway API routes are enabled for the NIMService
+func (n *NIMService) IsGatewayRouteEnabled() bool {
+	return n.Spec.Expose.GatewayRoute.IsEnabled()
+}
+
+// GetGatewayRouteParams returns params to render Gateway API routes from templates
+func (n *NIMService) GetGatewayRouteParams() *rendertypes.GatewayRouteParams {
+	params := n.Spec.Expose.GatewayRoute.GetRouteParams(n.GetName(), n.GetServicePort())
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetGatewayRouteAnnotations()
+	return params
+}
+
 // GetRoleParams returns params to render Role from templates
 func (n *NIMService) GetRoleParams() *rendertypes.RoleParams {
 	params := &rendertypes.RoleParams{}
@@ -707,6 +1785,92 @@ func (n *NIMService) GetRoleBindingParams() *rendertypes.RoleBindingParams {
 	return params
 }
 
+// IsPDBEnabled returns true if a pod disruption budget is enabled for the NIMService deployment
+func (n *NIMService) IsPDBEnabled() bool {
+	return n.Spec.PDB.Enabled != nil && *n.Spec.PDB.Enabled
+}
+
+// GetPDBParams returns params to render a PodDisruptionBudget from templates
+func (n *NIMService) GetPDBParams() *rendertypes.PDBParams {
+	params := &rendertypes.PDBParams{}
+
+	params.Enabled = n.IsPDBEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNIMServiceAnnotations()
+	params.SelectorLabels = n.GetSelectorLabels()
+	params.MinAvailable = n.Spec.PDB.MinAvailable
+	params.MaxUnavailable = n.Spec.PDB.GetMaxUnavailable()
+	return params
+}
+
+// IsNetworkPolicyEnabled returns true if a network policy is enabled for the NIMService pods
+func (n *NIMService) IsNetworkPolicyEnabled() bool {
+	return n.Spec.NetworkPolicy.Enabled != nil && *n.Spec.NetworkPolicy.Enabled
+}
+
+// GetNetworkPolicyParams returns params to render a NetworkPolicy from templates
+func (n *NIMService) GetNetworkPolicyParams() *rendertypes.NetworkPolicyParams {
+	params := &rendertypes.NetworkPolicyParams{}
+
+	params.Enabled = n.IsNetworkPolicyEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNIMServiceAnnotations()
+	params.SelectorLabels = n.GetNetworkPolicySelectorLabels()
+
+	var dependencies []networkingv1.NetworkPolicyEgressRule
+	// Models are downloaded from NGC when they are not pre-cached by a NIMCache
+	if n.GetNIMCacheName() == "" {
+		dependencies = append(dependencies, egressRuleForEndpoint("api.ngc.nvidia.com", 443, n.GetNamespace()))
+	}
+	params.Ingress = n.Spec.NetworkPolicy.GetIngressRules(params.SelectorLabels, n.Spec.Expose.GetServicePorts(n.GetServicePort())...)
+	if n.IsScaleToZeroEnabled() {
+		// The activator forwards the requests held while the NIMService resumes
+		params.Ingress = append(params.Ingress, networkingv1.NetworkPolicyIngressRule{
+			Ports: []networkingv1.NetworkPolicyPort{networkPolicyPort(corev1.ProtocolTCP, n.GetServicePort())},
+			From:  []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": n.GetActivatorName()}}}},
+		})
+	}
+	dependencies = append(dependencies, GetProxy(n.Spec.Proxy).GetEgressRules(n.GetNamespace())...)
+	params.Egress = n.Spec.NetworkPolicy.GetEgressRules(params.SelectorLabels, dependencies...)
+	return params
+}
+
+// GetNetworkPolicySelectorLabels returns the labels selecting the pods of all revisions of the NIMService
+func (n *NIMService) GetNetworkPolicySelectorLabels() map[string]string {
+	return map[string]string{"app.kubernetes.io/instance": n.GetName()}
+}
+
+// GetActivatorNetworkPolicyParams returns params to render the NetworkPolicy of the activator from templates
+func (n *NIMService) GetActivatorNetworkPolicyParams() *rendertypes.NetworkPolicyParams {
+	params := &rendertypes.NetworkPolicyParams{}
+
+	params.Enabled = n.IsNetworkPolicyEnabled() && n.IsScaleToZeroEnabled()
+	// Set metadata
+	params.Name = n.GetActivatorName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNIMServiceAnnotations()
+	params.SelectorLabels = map[string]string{"app": n.GetActivatorName()}
+
+	// The activator serves the requests of the NIMService clients, patches the NIMService through the API server and
+	// forwards the requests to the NIMService pods. The API server is outside of the pod network, only its port is restricted.
+	params.Ingress = n.Spec.NetworkPolicy.GetIngressRules(params.SelectorLabels, n.GetServicePort())
+	apiServer := networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			networkPolicyPort(corev1.ProtocolTCP, 443),
+			networkPolicyPort(corev1.ProtocolTCP, 6443),
+		},
+	}
+	params.Egress = n.Spec.NetworkPolicy.GetEgressRules(n.GetNetworkPolicySelectorLabels(), apiServer)
+	return params
+}
+
 // GetHPAParams returns params to render HPA from templates
 func (n *NIMService) GetHPAParams() *rendertypes.HPAParams {
 	params := &rendertypes.HPAParams{}
@@ -725,17 +1889,202 @@ func (n *NIMService) GetHPAParams() *rendertypes.HPAParams {
 		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
 			Kind:       n.GetDeploymentKind(),
 			Name:       n.GetName(),
-			APIVersion: "apps/v1",
+			APIVersion: n.GetDeploymentAPIVersion(),
 		},
 		MinReplicas: hpa.MinReplicas,
 		MaxReplicas: hpa.MaxReplicas,
-		Metrics:     hpa.Metrics,
+		Metrics:     n.GetAutoscalingMetrics(),
 		Behavior:    hpa.Behavior,
 	}
 	params.HPASpec = hpaSpec
 	return params
 }
 
+// GetAutoscalingMetrics returns the HPA metrics with the metrics of the autoscaling presets appended
+func (n *NIMService) GetAutoscalingMetrics() []autoscalingv2.MetricSpec {
+	metrics := append([]autoscalingv2.MetricSpec{}, n.Spec.Scale.HPA.Metrics...)
+	for _, preset := range n.Spec.Scale.Presets {
+		definition, ok := autoscalingPresets[preset.Name]
+		if !ok {
+			continue
+		}
+		target := resource.MustParse(definition.target)
+		if preset.Target != nil {
+			target = *preset.Target
+		}
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: definition.metric},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &target,
+				},
+			},
+		})
+	}
+	return metrics
+}
+
+// IsPrometheusAdapterEnabled returns true if the rules of the autoscaling presets are added to the prometheus-adapter configuration
+func (n *NIMService) IsPrometheusAdapterEnabled() bool {
+	return n.IsAutoScalingEnabled() && len(n.Spec.Scale.Presets) > 0 &&
+		n.Spec.Scale.PrometheusAdapter.Enabled != nil && *n.Spec.Scale.PrometheusAdapter.Enabled
+}
+
+// GetPrometheusAdapterConfigKey returns the key of the prometheus-adapter configuration file in its ConfigMap
+func (n *NIMService) GetPrometheusAdapterConfigKey() string {
+	if n.Spec.Scale.PrometheusAdapter.Key != "" {
+		return n.Spec.Scale.PrometheusAdapter.Key
+	}
+	return DefaultPrometheusAdapterConfigKey
+}
+
+// MergePrometheusAdapterRules adds the rules serving the preset metrics through the custom metrics API to the
+// prometheus-adapter configuration, replacing the rules of the same metrics and keeping the other rules
+func (n *NIMService) MergePrometheusAdapterRules(config string) (string, bool, error) {
+	type adapterResource struct {
+		Resource string `json:"resource"`
+	}
+	type adapterRule struct {
+		SeriesQuery string `json:"seriesQuery"`
+		Resources   struct {
+			Overrides map[string]adapterResource `json:"overrides"`
+		} `json:"resources"`
+		Name struct {
+			Matches string `json:"matches"`
+			As      string `json:"as"`
+		} `json:"name"`
+		MetricsQuery string `json:"metricsQuery"`
+	}
+
+	adapterConfig := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(config), &adapterConfig); err != nil {
+		return "", false, err
+	}
+	if adapterConfig == nil {
+		adapterConfig = map[string]interface{}{}
+	}
+	rules, ok := adapterConfig["rules"].([]interface{})
+	if !ok && adapterConfig["rules"] != nil {
+		return "", false, fmt.Errorf("rules is not a list")
+	}
+
+	// The rules serve the metrics of every pod, the HPA selects the pods of the NIMService
+	changed := false
+	for _, preset := range n.Spec.Scale.Presets {
+		definition, ok := autoscalingPresets[preset.Name]
+		if !ok {
+			continue
+		}
+		rule := adapterRule{
+			SeriesQuery:  fmt.Sprintf(`%s{namespace!="",pod!=""}`, definition.series),
+			MetricsQuery: definition.query,
+		}
+		rule.Resources.Overrides = map[string]adapterResource{
+			"namespace": {Resource: "namespace"},
+			"pod":       {Resource: "pod"},
+		}
+		rule.Name.Matches = fmt.Sprintf("^%s$", definition.series)
+		rule.Name.As = definition.metric
+
+		// Compare the rules in their decoded form
+		data, err := yaml.Marshal(rule)
+		if err != nil {
+			return "", false, err
+		}
+		var desired interface{}
+		if err := yaml.Unmarshal(data, &desired); err != nil {
+			return "", false, err
+		}
+		index := slices.IndexFunc(rules, func(existing interface{}) bool {
+			existingRule, ok := existing.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			name, ok := existingRule["name"].(map[string]interface{})
+			return ok && name["as"] == definition.metric
+		})
+		switch {
+		case index < 0:
+			rules = append(rules, desired)
+			changed = true
+		case !reflect.DeepEqual(rules[index], desired):
+			rules[index] = desired
+			changed = true
+		}
+	}
+	if !changed {
+		return config, false, nil
+	}
+
+	adapterConfig["rules"] = rules
+	merged, err := yaml.Marshal(adapterConfig)
+	if err != nil {
+		return "", false, err
+	}
+	return string(merged), true, nil
+}
+
+// GetScaledObjectParams returns params to render a KEDA ScaledObject from templates
+func (n *NIMService) GetScaledObjectParams() *rendertypes.ScaledObjectParams {
+	params := &rendertypes.ScaledObjectParams{}
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetHPAAnnotations()
+
+	// Set ScaledObject spec
+	keda := n.Spec.Scale.KEDA
+	params.ScaleTargetKind = n.GetDeploymentKind()
+	params.ScaleTargetAPI = n.GetDeploymentAPIVersion()
+	params.MinReplicas = keda.MinReplicas
+	params.MaxReplicas = keda.MaxReplicas
+	params.PollingInterval = keda.PollingInterval
+	params.CooldownPeriod = keda.CooldownPeriod
+	params.Behavior = keda.Behavior
+	for _, trigger := range keda.Triggers {
+		params.Triggers = append(params.Triggers, n.getKEDATrigger(keda.PrometheusURL, trigger))
+	}
+	return params
+}
+
+// getKEDATrigger returns the Prometheus trigger for the NIM metric
+func (n *NIMService) getKEDATrigger(prometheusURL string, trigger KEDATrigger) rendertypes.ScaledObjectTrigger {
+	selector := fmt.Sprintf(`namespace=%q,pod=~%q`, n.GetNamespace(), n.GetPodNameRegex())
+	query, threshold, metricType := trigger.Query, trigger.Threshold, "AverageValue"
+	switch trigger.Metric {
+	case KEDAMetricRequestsWaiting:
+		// Scale to keep the waiting requests per replica under the threshold
+		if query == "" {
+			query = fmt.Sprintf("sum(%s{%s})", KEDAMetricRequestsWaiting, selector)
+		}
+		if threshold == "" {
+			threshold = "10"
+		}
+	case KEDAMetricGPUCacheUsage:
+		// Scale to keep the average KV-cache utilization under the threshold
+		if query == "" {
+			query = fmt.Sprintf("avg(%s{%s})", KEDAMetricGPUCacheUsage, selector)
+		}
+		if threshold == "" {
+			threshold = "0.75"
+		}
+		metricType = "Value"
+	}
+	return rendertypes.ScaledObjectTrigger{
+		Type:       "prometheus",
+		MetricType: metricType,
+		Metadata: map[string]string{
+			"serverAddress": prometheusURL,
+			"query":         query,
+			"threshold":     threshold,
+		},
+	}
+}
+
 // GetSCCParams return params to render SCC from templates
 func (n *NIMService) GetSCCParams() *rendertypes.SCCParams {
 	params := &rendertypes.SCCParams{}
@@ -764,6 +2113,22 @@ func (n *NIMService) GetServiceMonitorParams() *rendertypes.ServiceMonitorParams
 		Selector:          metav1.LabelSelector{MatchLabels: n.GetServiceLabels()},
 		Endpoints:         []monitoringv1.Endpoint{{Port: "service-port", ScrapeTimeout: serviceMonitor.ScrapeTimeout, Interval: serviceMonitor.Interval}},
 	}
+	// Scrape over TLS, verifying the serving certificate with the CA from its secret
+	if n.IsTLSEnabled() {
+		serverName := fmt.Sprintf("%s.%s.svc", n.GetName(), n.GetNamespace())
+		smSpec.Endpoints[0].Scheme = "https"
+		smSpec.Endpoints[0].TLSConfig = &monitoringv1.TLSConfig{
+			SafeTLSConfig: monitoringv1.SafeTLSConfig{
+				CA: monitoringv1.SecretOrConfigMap{
+					Secret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: n.GetTLSSecretName()},
+						Key:                  "ca.crt",
+					},
+				},
+				ServerName: &serverName,
+			},
+		}
+	}
 	params.SMSpec = smSpec
 	return params
 }
@@ -777,6 +2142,16 @@ func (n *NIMService) GetIngressAnnotations() map[string]string {
 	return nimServiceAnnotations
 }
 
+// GetGatewayRouteAnnotations returns annotations to apply to the Gateway API routes
+func (n *NIMService) GetGatewayRouteAnnotations() map[string]string {
+	nimServiceAnnotations := n.GetNIMServiceAnnotations()
+
+	if n.Spec.Expose.GatewayRoute.Annotations != nil {
+		return utils.MergeMaps(nimServiceAnnotations, n.Spec.Expose.GatewayRoute.Annotations)
+	}
+	return nimServiceAnnotations
+}
+
 func (n *NIMService) GetServiceAnnotations() map[string]string {
 	nimServiceAnnotations := n.GetNIMServiceAnnotations()
 
@@ -18,9 +18,14 @@ package v1alpha1
 
 import (
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // TestGetVolumes tests the GetVolumes function.
@@ -115,3 +120,440 @@ func TestGetVolumes(t *testing.T) {
 	}
 
 }
+
+// TestGetAffinity tests the GetAffinity function.
+func TestGetAffinity(t *testing.T) {
+	podAffinity := &corev1.PodAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+			{Weight: 10, PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: "kubernetes.io/hostname"}},
+		},
+	}
+	podAntiAffinity := &corev1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+			{Weight: 50, PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: "topology.kubernetes.io/zone"}},
+		},
+	}
+	tests := []struct {
+		name       string
+		nimService *NIMService
+		desired    *corev1.Affinity
+	}{
+		{
+			name:       "No affinity",
+			nimService: &NIMService{},
+			desired:    nil,
+		},
+		{
+			name:       "Only pod affinity",
+			nimService: &NIMService{Spec: NIMServiceSpec{PodAffinity: podAffinity}},
+			desired:    &corev1.Affinity{PodAffinity: podAffinity},
+		},
+		{
+			name:       "Pod affinity merged with affinity",
+			nimService: &NIMService{Spec: NIMServiceSpec{PodAffinity: podAffinity, Affinity: &corev1.Affinity{PodAntiAffinity: podAntiAffinity}}},
+			desired:    &corev1.Affinity{PodAffinity: podAffinity, PodAntiAffinity: podAntiAffinity},
+		},
+		{
+			name:       "Affinity pod affinity takes precedence",
+			nimService: &NIMService{Spec: NIMServiceSpec{PodAffinity: &corev1.PodAffinity{}, Affinity: &corev1.Affinity{PodAffinity: podAffinity}}},
+			desired:    &corev1.Affinity{PodAffinity: podAffinity},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			affinity := tt.nimService.GetAffinity()
+			if !reflect.DeepEqual(affinity, tt.desired) {
+				t.Errorf("GetAffinity() = %v, want %v", affinity, tt.desired)
+			}
+		})
+	}
+}
+
+// TestGetGatewayRouteParams tests the backend defaults of the GetGatewayRouteParams function.
+func TestGetGatewayRouteParams(t *testing.T) {
+	enabled := true
+	weight := int32(20)
+	serviceBackend := rendertypes.GatewayBackendRef{Name: "test-nim", Port: 8000}
+	tests := []struct {
+		name    string
+		route   GatewayRoute
+		desired []rendertypes.HTTPRouteRule
+	}{
+		{
+			name:    "No rules",
+			route:   GatewayRoute{Enabled: &enabled},
+			desired: []rendertypes.HTTPRouteRule{{BackendRefs: []rendertypes.GatewayBackendRef{serviceBackend}}},
+		},
+		{
+			name: "Header match without backends",
+			route: GatewayRoute{Enabled: &enabled, Rules: []HTTPRouteRule{
+				{Matches: []HTTPRouteMatch{{Headers: []HTTPHeaderMatch{{Type: "Exact", Name: "x-model", Value: "llama"}}}}},
+			}},
+			desired: []rendertypes.HTTPRouteRule{
+				{
+					Matches:     []rendertypes.HTTPRouteMatch{{Headers: []rendertypes.HTTPHeaderMatch{{Type: "Exact", Name: "x-model", Value: "llama"}}}},
+					BackendRefs: []rendertypes.GatewayBackendRef{serviceBackend},
+				},
+			},
+		},
+		{
+			name: "Weighted backends",
+			route: GatewayRoute{Enabled: &enabled, Rules: []HTTPRouteRule{
+				{BackendRefs: []GatewayBackendReference{{Weight: &weight}, {Name: "canary-nim", Port: 9000, Weight: &weight}}},
+			}},
+			desired: []rendertypes.HTTPRouteRule{
+				{
+					BackendRefs: []rendertypes.GatewayBackendRef{
+						{Name: "test-nim", Port: 8000, Weight: &weight},
+						{Name: "canary-nim", Port: 9000, Weight: &weight},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nimService := &NIMService{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-nim", Namespace: "default"},
+				Spec:       NIMServiceSpec{Expose: NIMServiceExpose{Expose: Expose{Service: Service{Port: 8000}, GatewayRoute: tt.route}}},
+			}
+			params := nimService.GetGatewayRouteParams()
+			if !params.Enabled {
+				t.Fatalf("GetGatewayRouteParams().Enabled = false, want true")
+			}
+			if params.GRPCBackendRef.Port != DefaultGRPCPort {
+				t.Errorf("GetGatewayRouteParams().GRPCBackendRef = %+v, want the gRPC port", params.GRPCBackendRef)
+			}
+			if !reflect.DeepEqual(params.Rules, tt.desired) {
+				t.Errorf("GetGatewayRouteParams().Rules = %+v, want %+v", params.Rules, tt.desired)
+			}
+		})
+	}
+}
+
+// TestTLS tests the pod and ingress settings for serving over TLS.
+func TestTLS(t *testing.T) {
+	enabled := true
+	nimService := &NIMService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-nim", Namespace: "default"},
+		Spec: NIMServiceSpec{
+			Expose: NIMServiceExpose{
+				Expose: Expose{
+					Service: Service{Port: 8000},
+					Ingress: Ingress{
+						Enabled: &enabled,
+						Spec:    networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "nim.example.com"}}},
+					},
+				},
+				TLS: TLS{
+					Enabled: &enabled,
+					CertManager: &CertManagerCertificate{
+						IssuerRef: CertManagerIssuerReference{Name: "test-issuer", Kind: "ClusterIssuer"},
+						DNSNames:  []string{"nim.example.com", "nim.internal"},
+					},
+				},
+			},
+		},
+	}
+
+	if got := nimService.GetTLSSecretName(); got != "test-nim-tls" {
+		t.Errorf("GetTLSSecretName() = %s, want test-nim-tls", got)
+	}
+
+	desiredDNSNames := []string{
+		"test-nim",
+		"test-nim.default",
+		"test-nim.default.svc",
+		"test-nim.default.svc.cluster.local",
+		"nim.example.com",
+		"nim.internal",
+	}
+	if got := nimService.GetTLSDNSNames(); !reflect.DeepEqual(got, desiredDNSNames) {
+		t.Errorf("GetTLSDNSNames() = %v, want %v", got, desiredDNSNames)
+	}
+
+	desiredEnv := corev1.EnvVar{Name: "NIM_SSL_MODE", Value: "TLS"}
+	found := false
+	for _, env := range nimService.GetEnv() {
+		if reflect.DeepEqual(env, desiredEnv) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("GetEnv() is missing %v", desiredEnv)
+	}
+
+	volumes := nimService.GetTLSVolumes()
+	if len(volumes) != 1 || volumes[0].Secret == nil || volumes[0].Secret.SecretName != "test-nim-tls" {
+		t.Errorf("GetTLSVolumes() = %+v, want the test-nim-tls secret", volumes)
+	}
+
+	if scheme := nimService.GetDefaultReadinessProbe().HTTPGet.Scheme; scheme != corev1.URISchemeHTTPS {
+		t.Errorf("GetDefaultReadinessProbe() scheme = %s, want %s", scheme, corev1.URISchemeHTTPS)
+	}
+
+	desiredIngressTLS := []networkingv1.IngressTLS{{Hosts: []string{"nim.example.com"}, SecretName: "test-nim-tls"}}
+	if got := nimService.GetIngressSpec().TLS; !reflect.DeepEqual(got, desiredIngressTLS) {
+		t.Errorf("GetIngressSpec().TLS = %+v, want %+v", got, desiredIngressTLS)
+	}
+	if len(nimService.Spec.Expose.Ingress.Spec.TLS) != 0 {
+		t.Errorf("GetIngressSpec() must not modify the NIMService spec")
+	}
+
+	params := nimService.GetCertificateParams()
+	if params.IssuerName != "test-issuer" || params.IssuerKind != "ClusterIssuer" || params.SecretName != "test-nim-tls" {
+		t.Errorf("GetCertificateParams() = %+v", params)
+	}
+}
+
+// TestGetProxy tests the proxy configuration of the NIMService pods.
+func TestGetProxy(t *testing.T) {
+	t.Setenv("HTTP_PROXY", "")
+	t.Setenv("HTTPS_PROXY", "http://operator-proxy:3128")
+	t.Setenv("NO_PROXY", "")
+	t.Setenv(ProxyCAConfigMapEnv, "")
+
+	nimService := &NIMService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-nim", Namespace: "default"},
+		Spec:       NIMServiceSpec{Proxy: &ProxySpec{NoProxy: ".svc", CertConfigMap: "ca-bundle"}},
+	}
+
+	desiredEnv := []corev1.EnvVar{
+		{Name: "HTTPS_PROXY", Value: "http://operator-proxy:3128"},
+		{Name: "https_proxy", Value: "http://operator-proxy:3128"},
+		{Name: "NO_PROXY", Value: ".svc"},
+		{Name: "no_proxy", Value: ".svc"},
+		{Name: "SSL_CERT_FILE", Value: "/etc/nim/proxy-ca/ca-bundle.crt"},
+		{Name: "REQUESTS_CA_BUNDLE", Value: "/etc/nim/proxy-ca/ca-bundle.crt"},
+		{Name: "CURL_CA_BUNDLE", Value: "/etc/nim/proxy-ca/ca-bundle.crt"},
+	}
+	env := nimService.GetEnv()
+	for _, desired := range desiredEnv {
+		found := false
+		for _, e := range env {
+			if reflect.DeepEqual(e, desired) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("GetEnv() is missing %v", desired)
+		}
+	}
+
+	volumes := nimService.GetVolumes(PersistentVolumeClaim{Name: "test-pvc"})
+	if last := volumes[len(volumes)-1]; last.ConfigMap == nil || last.ConfigMap.Name != "ca-bundle" {
+		t.Errorf("GetVolumes() = %+v, want the ca-bundle configmap", volumes)
+	}
+
+	rules := GetProxy(nimService.Spec.Proxy).GetEgressRules("default")
+	if len(rules) != 1 || len(rules[0].Ports) != 1 || rules[0].Ports[0].Port.IntValue() != 3128 {
+		t.Errorf("GetEgressRules() = %+v, want a rule for the operator-proxy port", rules)
+	}
+
+	// No proxy is configured without operator defaults or NIMService values
+	t.Setenv("HTTPS_PROXY", "")
+	if proxy := GetProxy(nil); proxy != nil {
+		t.Errorf("GetProxy(nil) = %+v, want nil", proxy)
+	}
+}
+
+// TestGetNetworkPolicyParams tests that the network policies cover all the pods serving the NIMService.
+func TestGetNetworkPolicyParams(t *testing.T) {
+	enabled := true
+	nimService := &NIMService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-nim", Namespace: "default"},
+		Spec: NIMServiceSpec{
+			Expose:        NIMServiceExpose{Expose: Expose{Service: Service{Port: 8000}}},
+			NetworkPolicy: NetworkPolicy{Enabled: &enabled},
+			ScaleToZero:   &ScaleToZeroSpec{PrometheusURL: "http://prometheus:9090"},
+		},
+	}
+
+	// The stable, candidate and leader-worker pods are labeled with the instance name
+	params := nimService.GetNetworkPolicyParams()
+	instance := map[string]string{"app.kubernetes.io/instance": "test-nim"}
+	if !reflect.DeepEqual(params.SelectorLabels, instance) {
+		t.Errorf("GetNetworkPolicyParams().SelectorLabels = %v, want %v", params.SelectorLabels, instance)
+	}
+	activator := map[string]string{"app": "test-nim-activator"}
+	last := params.Ingress[len(params.Ingress)-1]
+	if len(last.From) != 1 || !reflect.DeepEqual(last.From[0].PodSelector.MatchLabels, activator) || last.Ports[0].Port.IntValue() != 8000 {
+		t.Errorf("GetNetworkPolicyParams().Ingress = %+v, want a rule from the activator pods", params.Ingress)
+	}
+
+	activatorParams := nimService.GetActivatorNetworkPolicyParams()
+	if !activatorParams.Enabled || activatorParams.Name != "test-nim-activator" || !reflect.DeepEqual(activatorParams.SelectorLabels, activator) {
+		t.Errorf("GetActivatorNetworkPolicyParams() = %+v, want a policy for the activator pods", activatorParams)
+	}
+	found := false
+	for _, rule := range activatorParams.Egress {
+		if len(rule.To) == 1 && rule.To[0].PodSelector != nil && reflect.DeepEqual(rule.To[0].PodSelector.MatchLabels, instance) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("GetActivatorNetworkPolicyParams().Egress = %+v, want a rule to the NIMService pods", activatorParams.Egress)
+	}
+
+	// No activator without scaling to zero
+	nimService.Spec.ScaleToZero = nil
+	if nimService.GetActivatorNetworkPolicyParams().Enabled {
+		t.Errorf("GetActivatorNetworkPolicyParams().Enabled = true without scaleToZero")
+	}
+	if params := nimService.GetNetworkPolicyParams(); len(params.Ingress) != 2 {
+		t.Errorf("GetNetworkPolicyParams().Ingress = %+v, want no activator rule", params.Ingress)
+	}
+}
+
+// TestGetPodNameRegex tests that the pods of NIMServices sharing a name prefix are told apart.
+func TestGetPodNameRegex(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     NIMServiceSpec
+		matched  []string
+		excluded []string
+	}{
+		{
+			name:     "deployment",
+			matched:  []string{"test-nim-7d9f8b6c4-x2b9z", "test-nim-candidate-5c8d7f9b4-bcd2f"},
+			excluded: []string{"test-nim-large-7d9f8b6c4-x2b9z", "test-nim-2-7d9f8b6c4-x2b9z", "test-nim-activator-7d9f8b6c4-x2b9z"},
+		},
+		{
+			name:     "statefulset",
+			spec:     NIMServiceSpec{DeploymentKind: DeploymentKindStatefulSet},
+			matched:  []string{"test-nim-0", "test-nim-12"},
+			excluded: []string{"test-nim-large-0", "test-nim-7d9f8b6c4-x2b9z"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nimService := &NIMService{ObjectMeta: metav1.ObjectMeta{Name: "test-nim"}, Spec: tt.spec}
+			// Prometheus anchors the regular expressions of the label matchers
+			re := regexp.MustCompile("^(?:" + nimService.GetPodNameRegex() + ")$")
+			for _, pod := range tt.matched {
+				if !re.MatchString(pod) {
+					t.Errorf("GetPodNameRegex() = %s, want a match for %s", nimService.GetPodNameRegex(), pod)
+				}
+			}
+			for _, pod := range tt.excluded {
+				if re.MatchString(pod) {
+					t.Errorf("GetPodNameRegex() = %s, want no match for %s", nimService.GetPodNameRegex(), pod)
+				}
+			}
+		})
+	}
+}
+
+// TestMergePrometheusAdapterRules tests that the preset rules are added to the prometheus-adapter configuration.
+func TestMergePrometheusAdapterRules(t *testing.T) {
+	nimService := &NIMService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-nim", Namespace: "default"},
+		Spec: NIMServiceSpec{
+			Scale: NIMServiceAutoscaling{
+				Presets: []AutoscalingPreset{{Name: AutoscalingPresetQueueDepth}, {Name: AutoscalingPresetKVCacheUtilization}},
+			},
+		},
+	}
+	config := "rules:\n- name:\n    as: num_requests_waiting\n  seriesQuery: stale\n- name:\n    as: custom_metric\n  seriesQuery: custom_metric\n"
+
+	merged, changed, err := nimService.MergePrometheusAdapterRules(config)
+	if err != nil || !changed {
+		t.Fatalf("MergePrometheusAdapterRules() = %v, %v, want changed", changed, err)
+	}
+	for _, want := range []string{
+		`seriesQuery: num_requests_waiting{namespace!="",pod!=""}`,
+		`seriesQuery: gpu_cache_usage_perc{namespace!="",pod!=""}`,
+		"seriesQuery: custom_metric",
+	} {
+		if !strings.Contains(merged, want) {
+			t.Errorf("MergePrometheusAdapterRules() = %s, want %s", merged, want)
+		}
+	}
+	if strings.Contains(merged, "stale") || strings.Count(merged, "as: num_requests_waiting") != 1 {
+		t.Errorf("MergePrometheusAdapterRules() = %s, want the stale rule replaced", merged)
+	}
+	// The rules do not depend on the NIMService
+	if strings.Contains(merged, "test-nim") {
+		t.Errorf("MergePrometheusAdapterRules() = %s, want rules shared by all NIMServices", merged)
+	}
+
+	// Merging again leaves the configuration unchanged
+	if again, changed, err := nimService.MergePrometheusAdapterRules(merged); err != nil || changed || again != merged {
+		t.Errorf("MergePrometheusAdapterRules() = %v, %v, want unchanged", changed, err)
+	}
+
+	if _, _, err := nimService.MergePrometheusAdapterRules("rules: invalid"); err == nil {
+		t.Errorf("MergePrometheusAdapterRules() = nil, want an error for invalid rules")
+	}
+}
+
+// TestNIMOperatorConfig tests the operator defaults applied to the NIMService spec.
+func TestNIMOperatorConfig(t *testing.T) {
+	operatorConfig := &NIMOperatorConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: NIMOperatorConfigName},
+		Spec: NIMOperatorConfigSpec{
+			RegistryMirrors: []RegistryMirror{
+				{Mirror: "registry.example.com/nvcr/"},
+				{Source: "docker.io", Mirror: "registry.example.com/docker"},
+			},
+			PullSecrets:     []string{"mirror-secret"},
+			Proxy:           &ProxySpec{HTTPSProxy: "http://operator-proxy:3128", NoProxy: ".svc"},
+			GPUResourceName: "nvidia.com/mig-3g.40gb",
+			StorageClass:    "fast",
+		},
+	}
+
+	images := map[string]string{
+		"nvcr.io/nim/meta/llama3-8b-instruct": "registry.example.com/nvcr/nim/meta/llama3-8b-instruct",
+		"docker.io/library/busybox":           "registry.example.com/docker/library/busybox",
+		"nvcr.io.example.com/nim":             "nvcr.io.example.com/nim",
+		"quay.io/nim":                         "quay.io/nim",
+	}
+	for image, desired := range images {
+		if got := operatorConfig.GetImage(image); got != desired {
+			t.Errorf("GetImage(%q) = %q, want %q", image, got, desired)
+		}
+	}
+
+	nimService := &NIMService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-nim", Namespace: "default"},
+		Spec: NIMServiceSpec{
+			Image: Image{Repository: "nvcr.io/nim/meta/llama3-8b-instruct", Tag: "1.0.0"},
+			Proxy: &ProxySpec{NoProxy: ".cluster.local"},
+			Storage: NIMServiceStorage{
+				PVC: PersistentVolumeClaim{StorageClass: "standard"},
+			},
+		},
+	}
+	operatorConfig.ApplyToNIMService(nimService)
+
+	if nimService.Spec.Image.Repository != "registry.example.com/nvcr/nim/meta/llama3-8b-instruct" {
+		t.Errorf("ApplyToNIMService() image = %q", nimService.Spec.Image.Repository)
+	}
+	if !reflect.DeepEqual(nimService.Spec.Image.PullSecrets, []string{"mirror-secret"}) {
+		t.Errorf("ApplyToNIMService() pull secrets = %v", nimService.Spec.Image.PullSecrets)
+	}
+	if desired := (&ProxySpec{HTTPSProxy: "http://operator-proxy:3128", NoProxy: ".cluster.local"}); !reflect.DeepEqual(nimService.Spec.Proxy, desired) {
+		t.Errorf("ApplyToNIMService() proxy = %+v, want %+v", nimService.Spec.Proxy, desired)
+	}
+	if nimService.GetGPUResourceName() != "nvidia.com/mig-3g.40gb" {
+		t.Errorf("GetGPUResourceName() = %q", nimService.GetGPUResourceName())
+	}
+	// The values set in the NIMService take precedence
+	if nimService.Spec.Storage.PVC.StorageClass != "standard" {
+		t.Errorf("ApplyToNIMService() storage class = %q, want standard", nimService.Spec.Storage.PVC.StorageClass)
+	}
+	if operatorConfig.ApplyToNIMService(nimService); operatorConfig.Spec.Proxy.NoProxy != ".svc" {
+		t.Errorf("ApplyToNIMService() modified the operator config proxy")
+	}
+
+	// A missing NIMOperatorConfig leaves the NIMService unchanged
+	var missing *NIMOperatorConfig
+	unchanged := nimService.DeepCopy()
+	missing.ApplyToNIMService(unchanged)
+	if !reflect.DeepEqual(unchanged, nimService) {
+		t.Errorf("ApplyToNIMService() on a nil config = %+v", unchanged.Spec)
+	}
+}
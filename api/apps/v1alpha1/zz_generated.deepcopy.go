@@ -22,11 +22,38 @@ package v1alpha1
 
 import (
 	"k8s.io/api/autoscaling/v2"
-	"k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivatorSpec) DeepCopyInto(out *ActivatorSpec) {
+	*out = *in
+	if in.PullSecrets != nil {
+		in, out := &in.PullSecrets, &out.PullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WakeTimeout != nil {
+		in, out := &in.WakeTimeout, &out.WakeTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivatorSpec.
+func (in *ActivatorSpec) DeepCopy() *ActivatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Autoscaling) DeepCopyInto(out *Autoscaling) {
 	*out = *in
@@ -55,6 +82,26 @@ func (in *Autoscaling) DeepCopy() *Autoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPreset) DeepCopyInto(out *AutoscalingPreset) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPreset.
+func (in *AutoscalingPreset) DeepCopy() *AutoscalingPreset {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertConfig) DeepCopyInto(out *CertConfig) {
 	*out = *in
@@ -70,6 +117,72 @@ func (in *CertConfig) DeepCopy() *CertConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerCertificate) DeepCopyInto(out *CertManagerCertificate) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerCertificate.
+func (in *CertManagerCertificate) DeepCopy() *CertManagerCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerReference) DeepCopyInto(out *CertManagerIssuerReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference.
+func (in *CertManagerIssuerReference) DeepCopy() *CertManagerIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRAResources) DeepCopyInto(out *DRAResources) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRAResources.
+func (in *DRAResources) DeepCopy() *DRAResources {
+	if in == nil {
+		return nil
+	}
+	out := new(DRAResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataStoreSource) DeepCopyInto(out *DataStoreSource) {
 	*out = *in
@@ -105,6 +218,7 @@ func (in *Expose) DeepCopyInto(out *Expose) {
 	*out = *in
 	in.Service.DeepCopyInto(&out.Service)
 	in.Ingress.DeepCopyInto(&out.Ingress)
+	in.GatewayRoute.DeepCopyInto(&out.GatewayRoute)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Expose.
@@ -138,79 +252,62 @@ func (in *GPUSpec) DeepCopy() *GPUSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GuardrailConfig) DeepCopyInto(out *GuardrailConfig) {
+func (in *GRPCRoute) DeepCopyInto(out *GRPCRoute) {
 	*out = *in
-	if in.PVC != nil {
-		in, out := &in.PVC, &out.PVC
-		*out = new(PersistentVolumeClaim)
-		(*in).DeepCopyInto(*out)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailConfig.
-func (in *GuardrailConfig) DeepCopy() *GuardrailConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRoute.
+func (in *GRPCRoute) DeepCopy() *GRPCRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(GuardrailConfig)
+	out := new(GRPCRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscalerSpec) {
+func (in *GatewayBackendReference) DeepCopyInto(out *GatewayBackendReference) {
 	*out = *in
-	if in.MinReplicas != nil {
-		in, out := &in.MinReplicas, &out.MinReplicas
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
 		*out = new(int32)
 		**out = **in
 	}
-	if in.Metrics != nil {
-		in, out := &in.Metrics, &out.Metrics
-		*out = make([]v2.MetricSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Behavior != nil {
-		in, out := &in.Behavior, &out.Behavior
-		*out = new(v2.HorizontalPodAutoscalerBehavior)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerSpec.
-func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayBackendReference.
+func (in *GatewayBackendReference) DeepCopy() *GatewayBackendReference {
 	if in == nil {
 		return nil
 	}
-	out := new(HorizontalPodAutoscalerSpec)
+	out := new(GatewayBackendReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
+func (in *GatewayParentReference) DeepCopyInto(out *GatewayParentReference) {
 	*out = *in
-	if in.PullSecrets != nil {
-		in, out := &in.PullSecrets, &out.PullSecrets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
-func (in *Image) DeepCopy() *Image {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayParentReference.
+func (in *GatewayParentReference) DeepCopy() *GatewayParentReference {
 	if in == nil {
 		return nil
 	}
-	out := new(Image)
+	out := new(GatewayParentReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Ingress) DeepCopyInto(out *Ingress) {
+func (in *GatewayRoute) DeepCopyInto(out *GatewayRoute) {
 	*out = *in
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
@@ -224,200 +321,1216 @@ func (in *Ingress) DeepCopyInto(out *Ingress) {
 			(*out)[key] = val
 		}
 	}
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]GatewayParentReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]HTTPRouteRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.GRPC.DeepCopyInto(&out.GRPC)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingress.
-func (in *Ingress) DeepCopy() *Ingress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRoute.
+func (in *GatewayRoute) DeepCopy() *GatewayRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(Ingress)
+	out := new(GatewayRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressHost) DeepCopyInto(out *IngressHost) {
+func (in *GuardrailConfig) DeepCopyInto(out *GuardrailConfig) {
 	*out = *in
-	if in.Paths != nil {
-		in, out := &in.Paths, &out.Paths
-		*out = make([]IngressPath, len(*in))
-		copy(*out, *in)
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(PersistentVolumeClaim)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressHost.
-func (in *IngressHost) DeepCopy() *IngressHost {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailConfig.
+func (in *GuardrailConfig) DeepCopy() *GuardrailConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressHost)
+	out := new(GuardrailConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressPath) DeepCopyInto(out *IngressPath) {
+func (in *HTTPHeaderMatch) DeepCopyInto(out *HTTPHeaderMatch) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressPath.
-func (in *IngressPath) DeepCopy() *IngressPath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderMatch.
+func (in *HTTPHeaderMatch) DeepCopy() *HTTPHeaderMatch {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressPath)
+	out := new(HTTPHeaderMatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Metrics) DeepCopyInto(out *Metrics) {
+func (in *HTTPPathMatch) DeepCopyInto(out *HTTPPathMatch) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	in.ServiceMonitor.DeepCopyInto(&out.ServiceMonitor)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metrics.
-func (in *Metrics) DeepCopy() *Metrics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPathMatch.
+func (in *HTTPPathMatch) DeepCopy() *HTTPPathMatch {
 	if in == nil {
 		return nil
 	}
-	out := new(Metrics)
+	out := new(HTTPPathMatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+func (in *HTTPRouteMatch) DeepCopyInto(out *HTTPRouteMatch) {
 	*out = *in
-	if in.Profiles != nil {
-		in, out := &in.Profiles, &out.Profiles
-		*out = make([]string, len(*in))
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(HTTPPathMatch)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeaderMatch, len(*in))
 		copy(*out, *in)
 	}
-	if in.GPUs != nil {
-		in, out := &in.GPUs, &out.GPUs
-		*out = make([]GPUSpec, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteMatch.
+func (in *HTTPRouteMatch) DeepCopy() *HTTPRouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteRule) DeepCopyInto(out *HTTPRouteRule) {
+	*out = *in
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]HTTPRouteMatch, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Lora != nil {
-		in, out := &in.Lora, &out.Lora
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Buildable != nil {
-		in, out := &in.Buildable, &out.Buildable
-		*out = new(bool)
-		**out = **in
+	if in.BackendRefs != nil {
+		in, out := &in.BackendRefs, &out.BackendRefs
+		*out = make([]GatewayBackendReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
-func (in *ModelSpec) DeepCopy() *ModelSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteRule.
+func (in *HTTPRouteRule) DeepCopy() *HTTPRouteRule {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelSpec)
+	out := new(HTTPRouteRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NGCSource) DeepCopyInto(out *NGCSource) {
+func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscalerSpec) {
 	*out = *in
-	in.Model.DeepCopyInto(&out.Model)
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NGCSource.
-func (in *NGCSource) DeepCopy() *NGCSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerSpec.
+func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NGCSource)
+	out := new(HorizontalPodAutoscalerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCache) DeepCopyInto(out *NIMCache) {
+func (in *HuggingFaceSource) DeepCopyInto(out *HuggingFaceSource) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCache.
-func (in *NIMCache) DeepCopy() *NIMCache {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HuggingFaceSource.
+func (in *HuggingFaceSource) DeepCopy() *HuggingFaceSource {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCache)
+	out := new(HuggingFaceSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMCache) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheList) DeepCopyInto(out *NIMCacheList) {
+func (in *HuggingFaceStatus) DeepCopyInto(out *HuggingFaceStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NIMCache, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheList.
-func (in *NIMCacheList) DeepCopy() *NIMCacheList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HuggingFaceStatus.
+func (in *HuggingFaceStatus) DeepCopy() *HuggingFaceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheList)
+	out := new(HuggingFaceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMCacheList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = *in
+	if in.PullSecrets != nil {
+		in, out := &in.PullSecrets, &out.PullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
+	if in == nil {
+		return nil
+	}
+	out := new(Image)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ingress) DeepCopyInto(out *Ingress) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingress.
+func (in *Ingress) DeepCopy() *Ingress {
+	if in == nil {
+		return nil
+	}
+	out := new(Ingress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressHost) DeepCopyInto(out *IngressHost) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]IngressPath, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressHost.
+func (in *IngressHost) DeepCopy() *IngressHost {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressPath) DeepCopyInto(out *IngressPath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressPath.
+func (in *IngressPath) DeepCopy() *IngressPath {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDASpec) DeepCopyInto(out *KEDASpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]KEDATrigger, len(*in))
+		copy(*out, *in)
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDASpec.
+func (in *KEDASpec) DeepCopy() *KEDASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDATrigger) DeepCopyInto(out *KEDATrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDATrigger.
+func (in *KEDATrigger) DeepCopy() *KEDATrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDATrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KServeCacheSpec) DeepCopyInto(out *KServeCacheSpec) {
+	*out = *in
+	if in.LocalModelCache != nil {
+		in, out := &in.LocalModelCache, &out.LocalModelCache
+		*out = new(LocalModelCacheSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KServeCacheSpec.
+func (in *KServeCacheSpec) DeepCopy() *KServeCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KServeCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRAAdapter) DeepCopyInto(out *LoRAAdapter) {
+	*out = *in
+	if in.NGC != nil {
+		in, out := &in.NGC, &out.NGC
+		*out = new(LoRANGCSource)
+		**out = **in
+	}
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(LoRAPVCSource)
+		**out = **in
+	}
+	if in.DataStore != nil {
+		in, out := &in.DataStore, &out.DataStore
+		*out = new(DataStoreSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRAAdapter.
+func (in *LoRAAdapter) DeepCopy() *LoRAAdapter {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRAAdapter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRAAdapterStatus) DeepCopyInto(out *LoRAAdapterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRAAdapterStatus.
+func (in *LoRAAdapterStatus) DeepCopy() *LoRAAdapterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRAAdapterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRANGCSource) DeepCopyInto(out *LoRANGCSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRANGCSource.
+func (in *LoRANGCSource) DeepCopy() *LoRANGCSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRANGCSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRAPVCSource) DeepCopyInto(out *LoRAPVCSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRAPVCSource.
+func (in *LoRAPVCSource) DeepCopy() *LoRAPVCSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRAPVCSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRASpec) DeepCopyInto(out *LoRASpec) {
+	*out = *in
+	if in.Adapters != nil {
+		in, out := &in.Adapters, &out.Adapters
+		*out = make([]LoRAAdapter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRASpec.
+func (in *LoRASpec) DeepCopy() *LoRASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalModelCacheSpec) DeepCopyInto(out *LocalModelCacheSpec) {
+	*out = *in
+	if in.NodeGroups != nil {
+		in, out := &in.NodeGroups, &out.NodeGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ModelSize != nil {
+		in, out := &in.ModelSize, &out.ModelSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelCacheSpec.
+func (in *LocalModelCacheSpec) DeepCopy() *LocalModelCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalModelCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Metrics) DeepCopyInto(out *Metrics) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.ServiceMonitor.DeepCopyInto(&out.ServiceMonitor)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metrics.
+func (in *Metrics) DeepCopy() *Metrics {
+	if in == nil {
+		return nil
+	}
+	out := new(Metrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+	*out = *in
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GPUs != nil {
+		in, out := &in.GPUs, &out.GPUs
+		*out = make([]GPUSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Lora != nil {
+		in, out := &in.Lora, &out.Lora
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Buildable != nil {
+		in, out := &in.Buildable, &out.Buildable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
+func (in *ModelSpec) DeepCopy() *ModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiNodeStatus) DeepCopyInto(out *MultiNodeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiNodeStatus.
+func (in *MultiNodeStatus) DeepCopy() *MultiNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NGCSource) DeepCopyInto(out *NGCSource) {
+	*out = *in
+	in.Model.DeepCopyInto(&out.Model)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NGCSource.
+func (in *NGCSource) DeepCopy() *NGCSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NGCSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCache) DeepCopyInto(out *NIMCache) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCache.
+func (in *NIMCache) DeepCopy() *NIMCache {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMCache) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheList) DeepCopyInto(out *NIMCacheList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NIMCache, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheList.
+func (in *NIMCacheList) DeepCopy() *NIMCacheList {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMCacheList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheSpec) DeepCopyInto(out *NIMCacheSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Storage.DeepCopyInto(&out.Storage)
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.DRA != nil {
+		in, out := &in.DRA, &out.DRA
+		*out = new(DRAResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CertConfig != nil {
+		in, out := &in.CertConfig, &out.CertConfig
+		*out = new(CertConfig)
+		**out = **in
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KServe != nil {
+		in, out := &in.KServe, &out.KServe
+		*out = new(KServeCacheSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationSpec)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(ProgressSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheSpec.
+func (in *NIMCacheSpec) DeepCopy() *NIMCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheStatus) DeepCopyInto(out *NIMCacheStatus) {
+	*out = *in
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]NIMProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HuggingFace != nil {
+		in, out := &in.HuggingFace, &out.HuggingFace
+		*out = new(HuggingFaceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Status)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = make([]ProfileVerification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = make([]ProfileProgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheStatus.
+func (in *NIMCacheStatus) DeepCopy() *NIMCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheStorage) DeepCopyInto(out *NIMCacheStorage) {
+	*out = *in
+	in.PVC.DeepCopyInto(&out.PVC)
+	if in.HostPath != nil {
+		in, out := &in.HostPath, &out.HostPath
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheStorage.
+func (in *NIMCacheStorage) DeepCopy() *NIMCacheStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheVolSpec) DeepCopyInto(out *NIMCacheVolSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheVolSpec.
+func (in *NIMCacheVolSpec) DeepCopy() *NIMCacheVolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheVolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMOperatorConfig) DeepCopyInto(out *NIMOperatorConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMOperatorConfig.
+func (in *NIMOperatorConfig) DeepCopy() *NIMOperatorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMOperatorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMOperatorConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMOperatorConfigList) DeepCopyInto(out *NIMOperatorConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NIMOperatorConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMOperatorConfigList.
+func (in *NIMOperatorConfigList) DeepCopy() *NIMOperatorConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMOperatorConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMOperatorConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMOperatorConfigSpec) DeepCopyInto(out *NIMOperatorConfigSpec) {
+	*out = *in
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]RegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.PullSecrets != nil {
+		in, out := &in.PullSecrets, &out.PullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMOperatorConfigSpec.
+func (in *NIMOperatorConfigSpec) DeepCopy() *NIMOperatorConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMOperatorConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMPipeline) DeepCopyInto(out *NIMPipeline) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipeline.
+func (in *NIMPipeline) DeepCopy() *NIMPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMPipeline) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMPipelineList) DeepCopyInto(out *NIMPipelineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NIMPipeline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineList.
+func (in *NIMPipelineList) DeepCopy() *NIMPipelineList {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMPipelineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMPipelineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheSpec) DeepCopyInto(out *NIMCacheSpec) {
+func (in *NIMPipelineSpec) DeepCopyInto(out *NIMPipelineSpec) {
 	*out = *in
-	in.Source.DeepCopyInto(&out.Source)
-	in.Storage.DeepCopyInto(&out.Storage)
-	in.Resources.DeepCopyInto(&out.Resources)
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]NIMServicePipelineSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineSpec.
+func (in *NIMPipelineSpec) DeepCopy() *NIMPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMPipelineStatus) DeepCopyInto(out *NIMPipelineStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineStatus.
+func (in *NIMPipelineStatus) DeepCopy() *NIMPipelineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMPipelineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMProfile) DeepCopyInto(out *NIMProfile) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMProfile.
+func (in *NIMProfile) DeepCopy() *NIMProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMService) DeepCopyInto(out *NIMService) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMService.
+func (in *NIMService) DeepCopy() *NIMService {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMServiceAutoscaling) DeepCopyInto(out *NIMServiceAutoscaling) {
+	*out = *in
+	in.Autoscaling.DeepCopyInto(&out.Autoscaling)
+	if in.KEDA != nil {
+		in, out := &in.KEDA, &out.KEDA
+		*out = new(KEDASpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Presets != nil {
+		in, out := &in.Presets, &out.Presets
+		*out = make([]AutoscalingPreset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.PrometheusAdapter.DeepCopyInto(&out.PrometheusAdapter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceAutoscaling.
+func (in *NIMServiceAutoscaling) DeepCopy() *NIMServiceAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMServiceAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMServiceExpose) DeepCopyInto(out *NIMServiceExpose) {
+	*out = *in
+	in.Expose.DeepCopyInto(&out.Expose)
+	in.TLS.DeepCopyInto(&out.TLS)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceExpose.
+func (in *NIMServiceExpose) DeepCopy() *NIMServiceExpose {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMServiceExpose)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMServiceList) DeepCopyInto(out *NIMServiceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NIMService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceList.
+func (in *NIMServiceList) DeepCopy() *NIMServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMServicePipelineSpec) DeepCopyInto(out *NIMServicePipelineSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ServiceDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServicePipelineSpec.
+func (in *NIMServicePipelineSpec) DeepCopy() *NIMServicePipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMServicePipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMServiceSpec) DeepCopyInto(out *NIMServiceSpec) {
+	*out = *in
+	in.Image.DeepCopyInto(&out.Image)
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
@@ -425,6 +1538,43 @@ func (in *NIMCacheSpec) DeepCopyInto(out *NIMCacheSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodAffinity != nil {
+		in, out := &in.PodAffinity, &out.PodAffinity
+		*out = new(corev1.PodAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Expose.DeepCopyInto(&out.Expose)
+	in.LivenessProbe.DeepCopyInto(&out.LivenessProbe)
+	in.ReadinessProbe.DeepCopyInto(&out.ReadinessProbe)
+	in.StartupProbe.DeepCopyInto(&out.StartupProbe)
+	in.Scale.DeepCopyInto(&out.Scale)
+	in.Metrics.DeepCopyInto(&out.Metrics)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.PDB.DeepCopyInto(&out.PDB)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	if in.UserID != nil {
 		in, out := &in.UserID, &out.UserID
 		*out = new(int64)
@@ -435,97 +1585,184 @@ func (in *NIMCacheSpec) DeepCopyInto(out *NIMCacheSpec) {
 		*out = new(int64)
 		**out = **in
 	}
-	if in.CertConfig != nil {
-		in, out := &in.CertConfig, &out.CertConfig
-		*out = new(CertConfig)
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]VolumeClaimTemplate, len(*in))
+		copy(*out, *in)
+	}
+	if in.MultiNode != nil {
+		in, out := &in.MultiNode, &out.MultiNode
+		*out = new(NimServiceMultiNodeConfig)
 		**out = **in
 	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoRA != nil {
+		in, out := &in.LoRA, &out.LoRA
+		*out = new(LoRASpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleToZero != nil {
+		in, out := &in.ScaleToZero, &out.ScaleToZero
+		*out = new(ScaleToZeroSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DRA != nil {
+		in, out := &in.DRA, &out.DRA
+		*out = new(DRAResources)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheSpec.
-func (in *NIMCacheSpec) DeepCopy() *NIMCacheSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceSpec.
+func (in *NIMServiceSpec) DeepCopy() *NIMServiceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheSpec)
+	out := new(NIMServiceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheStatus) DeepCopyInto(out *NIMCacheStatus) {
+func (in *NIMServiceStatus) DeepCopyInto(out *NIMServiceStatus) {
 	*out = *in
-	if in.Profiles != nil {
-		in, out := &in.Profiles, &out.Profiles
-		*out = make([]NIMProfile, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MultiNode != nil {
+		in, out := &in.MultiNode, &out.MultiNode
+		*out = new(MultiNodeStatus)
+		**out = **in
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoRAAdapters != nil {
+		in, out := &in.LoRAAdapters, &out.LoRAAdapters
+		*out = make([]LoRAAdapterStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Suspension != nil {
+		in, out := &in.Suspension, &out.Suspension
+		*out = new(SuspensionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(ProfileSelectionStatus)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheStatus.
-func (in *NIMCacheStatus) DeepCopy() *NIMCacheStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceStatus.
+func (in *NIMServiceStatus) DeepCopy() *NIMServiceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheStatus)
+	out := new(NIMServiceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheStorage) DeepCopyInto(out *NIMCacheStorage) {
+func (in *NIMServiceStorage) DeepCopyInto(out *NIMServiceStorage) {
 	*out = *in
+	out.NIMCache = in.NIMCache
 	in.PVC.DeepCopyInto(&out.PVC)
 	if in.HostPath != nil {
 		in, out := &in.HostPath, &out.HostPath
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReadOnly != nil {
+		in, out := &in.ReadOnly, &out.ReadOnly
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheStorage.
-func (in *NIMCacheStorage) DeepCopy() *NIMCacheStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceStorage.
+func (in *NIMServiceStorage) DeepCopy() *NIMServiceStorage {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheStorage)
+	out := new(NIMServiceStorage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheVolSpec) DeepCopyInto(out *NIMCacheVolSpec) {
+func (in *NIMSource) DeepCopyInto(out *NIMSource) {
 	*out = *in
+	if in.NGC != nil {
+		in, out := &in.NGC, &out.NGC
+		*out = new(NGCSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataStore != nil {
+		in, out := &in.DataStore, &out.DataStore
+		*out = new(DataStoreSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HuggingFace != nil {
+		in, out := &in.HuggingFace, &out.HuggingFace
+		*out = new(HuggingFaceSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Source)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCISource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheVolSpec.
-func (in *NIMCacheVolSpec) DeepCopy() *NIMCacheVolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMSource.
+func (in *NIMSource) DeepCopy() *NIMSource {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheVolSpec)
+	out := new(NIMSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMPipeline) DeepCopyInto(out *NIMPipeline) {
+func (in *NemoDataStoreParams) DeepCopyInto(out *NemoDataStoreParams) {
+	*out = *in
+	if in.InitContainerCommand != nil {
+		in, out := &in.InitContainerCommand, &out.InitContainerCommand
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDataStoreParams.
+func (in *NemoDataStoreParams) DeepCopy() *NemoDataStoreParams {
+	if in == nil {
+		return nil
+	}
+	out := new(NemoDataStoreParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NemoDatastore) DeepCopyInto(out *NemoDatastore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -533,18 +1770,18 @@ func (in *NIMPipeline) DeepCopyInto(out *NIMPipeline) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipeline.
-func (in *NIMPipeline) DeepCopy() *NIMPipeline {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDatastore.
+func (in *NemoDatastore) DeepCopy() *NemoDatastore {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMPipeline)
+	out := new(NemoDatastore)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMPipeline) DeepCopyObject() runtime.Object {
+func (in *NemoDatastore) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -552,31 +1789,31 @@ func (in *NIMPipeline) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMPipelineList) DeepCopyInto(out *NIMPipelineList) {
+func (in *NemoDatastoreList) DeepCopyInto(out *NemoDatastoreList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NIMPipeline, len(*in))
+		*out = make([]NemoDatastore, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineList.
-func (in *NIMPipelineList) DeepCopy() *NIMPipelineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDatastoreList.
+func (in *NemoDatastoreList) DeepCopy() *NemoDatastoreList {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMPipelineList)
+	out := new(NemoDatastoreList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMPipelineList) DeepCopyObject() runtime.Object {
+func (in *NemoDatastoreList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -584,80 +1821,136 @@ func (in *NIMPipelineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMPipelineSpec) DeepCopyInto(out *NIMPipelineSpec) {
+func (in *NemoDatastoreSpec) DeepCopyInto(out *NemoDatastoreSpec) {
 	*out = *in
-	if in.Services != nil {
-		in, out := &in.Services, &out.Services
-		*out = make([]NIMServicePipelineSpec, len(*in))
+	in.Image.DeepCopyInto(&out.Image)
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineSpec.
-func (in *NIMPipelineSpec) DeepCopy() *NIMPipelineSpec {
-	if in == nil {
-		return nil
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
 	}
-	out := new(NIMPipelineSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMPipelineStatus) DeepCopyInto(out *NIMPipelineStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
-	if in.States != nil {
-		in, out := &in.States, &out.States
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodAffinity != nil {
+		in, out := &in.PodAffinity, &out.PodAffinity
+		*out = new(corev1.PodAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Expose.DeepCopyInto(&out.Expose)
+	in.LivenessProbe.DeepCopyInto(&out.LivenessProbe)
+	in.ReadinessProbe.DeepCopyInto(&out.ReadinessProbe)
+	in.StartupProbe.DeepCopyInto(&out.StartupProbe)
+	in.Scale.DeepCopyInto(&out.Scale)
+	in.Metrics.DeepCopyInto(&out.Metrics)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.PDB.DeepCopyInto(&out.PDB)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int64)
+		**out = **in
+	}
+	in.DataStoreParams.DeepCopyInto(&out.DataStoreParams)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineStatus.
-func (in *NIMPipelineStatus) DeepCopy() *NIMPipelineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDatastoreSpec.
+func (in *NemoDatastoreSpec) DeepCopy() *NemoDatastoreSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMPipelineStatus)
+	out := new(NemoDatastoreSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMProfile) DeepCopyInto(out *NIMProfile) {
+func (in *NemoDatastoreStatus) DeepCopyInto(out *NemoDatastoreStatus) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMProfile.
-func (in *NIMProfile) DeepCopy() *NIMProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDatastoreStatus.
+func (in *NemoDatastoreStatus) DeepCopy() *NemoDatastoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMProfile)
+	out := new(NemoDatastoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMService) DeepCopyInto(out *NIMService) {
+func (in *NemoGuardrail) DeepCopyInto(out *NemoGuardrail) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -665,18 +1958,18 @@ func (in *NIMService) DeepCopyInto(out *NIMService) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMService.
-func (in *NIMService) DeepCopy() *NIMService {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoGuardrail.
+func (in *NemoGuardrail) DeepCopy() *NemoGuardrail {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMService)
+	out := new(NemoGuardrail)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMService) DeepCopyObject() runtime.Object {
+func (in *NemoGuardrail) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -684,31 +1977,31 @@ func (in *NIMService) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMServiceList) DeepCopyInto(out *NIMServiceList) {
+func (in *NemoGuardrailList) DeepCopyInto(out *NemoGuardrailList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NIMService, len(*in))
+		*out = make([]NemoGuardrail, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceList.
-func (in *NIMServiceList) DeepCopy() *NIMServiceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoGuardrailList.
+func (in *NemoGuardrailList) DeepCopy() *NemoGuardrailList {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMServiceList)
+	out := new(NemoGuardrailList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMServiceList) DeepCopyObject() runtime.Object {
+func (in *NemoGuardrailList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -716,33 +2009,7 @@ func (in *NIMServiceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMServicePipelineSpec) DeepCopyInto(out *NIMServicePipelineSpec) {
-	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	in.Spec.DeepCopyInto(&out.Spec)
-	if in.Dependencies != nil {
-		in, out := &in.Dependencies, &out.Dependencies
-		*out = make([]ServiceDependency, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServicePipelineSpec.
-func (in *NIMServicePipelineSpec) DeepCopy() *NIMServicePipelineSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(NIMServicePipelineSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMServiceSpec) DeepCopyInto(out *NIMServiceSpec) {
+func (in *NemoGuardrailSpec) DeepCopyInto(out *NemoGuardrailSpec) {
 	*out = *in
 	in.Image.DeepCopyInto(&out.Image)
 	if in.Command != nil {
@@ -757,12 +2024,17 @@ func (in *NIMServiceSpec) DeepCopyInto(out *NIMServiceSpec) {
 	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
+	}
+	in.ConfigStore.DeepCopyInto(&out.ConfigStore)
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -786,19 +2058,19 @@ func (in *NIMServiceSpec) DeepCopyInto(out *NIMServiceSpec) {
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.PodAffinity != nil {
 		in, out := &in.PodAffinity, &out.PodAffinity
-		*out = new(v1.PodAffinity)
+		*out = new(corev1.PodAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	in.Expose.DeepCopyInto(&out.Expose)
@@ -807,6 +2079,20 @@ func (in *NIMServiceSpec) DeepCopyInto(out *NIMServiceSpec) {
 	in.StartupProbe.DeepCopyInto(&out.StartupProbe)
 	in.Scale.DeepCopyInto(&out.Scale)
 	in.Metrics.DeepCopyInto(&out.Metrics)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.PDB.DeepCopyInto(&out.PDB)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	if in.UserID != nil {
 		in, out := &in.UserID, &out.UserID
 		*out = new(int64)
@@ -819,506 +2105,448 @@ func (in *NIMServiceSpec) DeepCopyInto(out *NIMServiceSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceSpec.
-func (in *NIMServiceSpec) DeepCopy() *NIMServiceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoGuardrailSpec.
+func (in *NemoGuardrailSpec) DeepCopy() *NemoGuardrailSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMServiceSpec)
+	out := new(NemoGuardrailSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMServiceStatus) DeepCopyInto(out *NIMServiceStatus) {
+func (in *NemoGuardrailStatus) DeepCopyInto(out *NemoGuardrailStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceStatus.
-func (in *NIMServiceStatus) DeepCopy() *NIMServiceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoGuardrailStatus.
+func (in *NemoGuardrailStatus) DeepCopy() *NemoGuardrailStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMServiceStatus)
+	out := new(NemoGuardrailStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMServiceStorage) DeepCopyInto(out *NIMServiceStorage) {
+func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
 	*out = *in
-	out.NIMCache = in.NIMCache
-	in.PVC.DeepCopyInto(&out.PVC)
-	if in.HostPath != nil {
-		in, out := &in.HostPath, &out.HostPath
-		*out = new(string)
-		**out = **in
-	}
-	if in.ReadOnly != nil {
-		in, out := &in.ReadOnly, &out.ReadOnly
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceStorage.
-func (in *NIMServiceStorage) DeepCopy() *NIMServiceStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicy.
+func (in *NetworkPolicy) DeepCopy() *NetworkPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMServiceStorage)
+	out := new(NetworkPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMSource) DeepCopyInto(out *NIMSource) {
+func (in *NimServiceMultiNodeConfig) DeepCopyInto(out *NimServiceMultiNodeConfig) {
 	*out = *in
-	if in.NGC != nil {
-		in, out := &in.NGC, &out.NGC
-		*out = new(NGCSource)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DataStore != nil {
-		in, out := &in.DataStore, &out.DataStore
-		*out = new(DataStoreSource)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMSource.
-func (in *NIMSource) DeepCopy() *NIMSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NimServiceMultiNodeConfig.
+func (in *NimServiceMultiNodeConfig) DeepCopy() *NimServiceMultiNodeConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMSource)
+	out := new(NimServiceMultiNodeConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NemoDataStoreParams) DeepCopyInto(out *NemoDataStoreParams) {
+func (in *OCISource) DeepCopyInto(out *OCISource) {
 	*out = *in
-	if in.InitContainerCommand != nil {
-		in, out := &in.InitContainerCommand, &out.InitContainerCommand
+	if in.PullSecrets != nil {
+		in, out := &in.PullSecrets, &out.PullSecrets
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDataStoreParams.
-func (in *NemoDataStoreParams) DeepCopy() *NemoDataStoreParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISource.
+func (in *OCISource) DeepCopy() *OCISource {
 	if in == nil {
 		return nil
 	}
-	out := new(NemoDataStoreParams)
+	out := new(OCISource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NemoDatastore) DeepCopyInto(out *NemoDatastore) {
+func (in *OCIStatus) DeepCopyInto(out *OCIStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDatastore.
-func (in *NemoDatastore) DeepCopy() *NemoDatastore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIStatus.
+func (in *OCIStatus) DeepCopy() *OCIStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NemoDatastore)
+	out := new(OCIStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NemoDatastore) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NemoDatastoreList) DeepCopyInto(out *NemoDatastoreList) {
+func (in *PersistentVolumeClaim) DeepCopyInto(out *PersistentVolumeClaim) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NemoDatastore, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDatastoreList.
-func (in *NemoDatastoreList) DeepCopy() *NemoDatastoreList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaim.
+func (in *PersistentVolumeClaim) DeepCopy() *PersistentVolumeClaim {
 	if in == nil {
 		return nil
 	}
-	out := new(NemoDatastoreList)
+	out := new(PersistentVolumeClaim)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NemoDatastoreList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NemoDatastoreSpec) DeepCopyInto(out *NemoDatastoreSpec) {
+func (in *PodDisruptionBudget) DeepCopyInto(out *PodDisruptionBudget) {
 	*out = *in
-	in.Image.DeepCopyInto(&out.Image)
-	if in.Command != nil {
-		in, out := &in.Command, &out.Command
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Args != nil {
-		in, out := &in.Args, &out.Args
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
 	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
 	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudget.
+func (in *PodDisruptionBudget) DeepCopy() *PodDisruptionBudget {
+	if in == nil {
+		return nil
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(PodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Probe) DeepCopyInto(out *Probe) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
 	}
-	if in.PodAffinity != nil {
-		in, out := &in.PodAffinity, &out.PodAffinity
-		*out = new(v1.PodAffinity)
+	if in.Probe != nil {
+		in, out := &in.Probe, &out.Probe
+		*out = new(corev1.Probe)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
+func (in *Probe) DeepCopy() *Probe {
+	if in == nil {
+		return nil
 	}
-	in.Expose.DeepCopyInto(&out.Expose)
-	in.LivenessProbe.DeepCopyInto(&out.LivenessProbe)
-	in.ReadinessProbe.DeepCopyInto(&out.ReadinessProbe)
-	in.StartupProbe.DeepCopyInto(&out.StartupProbe)
-	in.Scale.DeepCopyInto(&out.Scale)
-	in.Metrics.DeepCopyInto(&out.Metrics)
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int64)
-		**out = **in
+	out := new(Probe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileProgress) DeepCopyInto(out *ProfileProgress) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
-		*out = new(int64)
-		**out = **in
+	if in.FinishTime != nil {
+		in, out := &in.FinishTime, &out.FinishTime
+		*out = (*in).DeepCopy()
 	}
-	in.DataStoreParams.DeepCopyInto(&out.DataStoreParams)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDatastoreSpec.
-func (in *NemoDatastoreSpec) DeepCopy() *NemoDatastoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileProgress.
+func (in *ProfileProgress) DeepCopy() *ProfileProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSelectionStatus) DeepCopyInto(out *ProfileSelectionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSelectionStatus.
+func (in *ProfileSelectionStatus) DeepCopy() *ProfileSelectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NemoDatastoreSpec)
+	out := new(ProfileSelectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NemoDatastoreStatus) DeepCopyInto(out *NemoDatastoreStatus) {
+func (in *ProfileVerification) DeepCopyInto(out *ProfileVerification) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.FailedFiles != nil {
+		in, out := &in.FailedFiles, &out.FailedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDatastoreStatus.
-func (in *NemoDatastoreStatus) DeepCopy() *NemoDatastoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileVerification.
+func (in *ProfileVerification) DeepCopy() *ProfileVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(NemoDatastoreStatus)
+	out := new(ProfileVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NemoGuardrail) DeepCopyInto(out *NemoGuardrail) {
+func (in *ProgressSpec) DeepCopyInto(out *ProgressSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoGuardrail.
-func (in *NemoGuardrail) DeepCopy() *NemoGuardrail {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProgressSpec.
+func (in *ProgressSpec) DeepCopy() *ProgressSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NemoGuardrail)
+	out := new(ProgressSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NemoGuardrail) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusAdapter) DeepCopyInto(out *PrometheusAdapter) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
 	}
-	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusAdapter.
+func (in *PrometheusAdapter) DeepCopy() *PrometheusAdapter {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusAdapter)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NemoGuardrailList) DeepCopyInto(out *NemoGuardrailList) {
+func (in *ProxySpec) DeepCopyInto(out *ProxySpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NemoGuardrail, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoGuardrailList.
-func (in *NemoGuardrailList) DeepCopy() *NemoGuardrailList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySpec.
+func (in *ProxySpec) DeepCopy() *ProxySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NemoGuardrailList)
+	out := new(ProxySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NemoGuardrailList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(RegistryMirror)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NemoGuardrailSpec) DeepCopyInto(out *NemoGuardrailSpec) {
+func (in *Resources) DeepCopyInto(out *Resources) {
 	*out = *in
-	in.Image.DeepCopyInto(&out.Image)
-	if in.Command != nil {
-		in, out := &in.Command, &out.Command
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.CPU = in.CPU.DeepCopy()
+	out.Memory = in.Memory.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
+func (in *Resources) DeepCopy() *Resources {
+	if in == nil {
+		return nil
 	}
-	if in.Args != nil {
-		in, out := &in.Args, &out.Args
-		*out = make([]string, len(*in))
+	out := new(Resources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]int32, len(*in))
 		copy(*out, *in)
 	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	in.ConfigStore.DeepCopyInto(&out.ConfigStore)
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.PodAffinity != nil {
-		in, out := &in.PodAffinity, &out.PodAffinity
-		*out = new(v1.PodAffinity)
-		(*in).DeepCopyInto(*out)
+	if in.StepInterval != nil {
+		in, out := &in.StepInterval, &out.StepInterval
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.ProgressDeadline != nil {
+		in, out := &in.ProgressDeadline, &out.ProgressDeadline
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	in.Expose.DeepCopyInto(&out.Expose)
-	in.LivenessProbe.DeepCopyInto(&out.LivenessProbe)
-	in.ReadinessProbe.DeepCopyInto(&out.ReadinessProbe)
-	in.StartupProbe.DeepCopyInto(&out.StartupProbe)
-	in.Scale.DeepCopyInto(&out.Scale)
-	in.Metrics.DeepCopyInto(&out.Metrics)
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int64)
+	if in.SmokeTest != nil {
+		in, out := &in.SmokeTest, &out.SmokeTest
+		*out = new(SmokeTest)
 		**out = **in
 	}
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
-		*out = new(int64)
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoGuardrailSpec.
-func (in *NemoGuardrailSpec) DeepCopy() *NemoGuardrailSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutSpec.
+func (in *RolloutSpec) DeepCopy() *RolloutSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NemoGuardrailSpec)
+	out := new(RolloutSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NemoGuardrailStatus) DeepCopyInto(out *NemoGuardrailStatus) {
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.StepStartTime != nil {
+		in, out := &in.StepStartTime, &out.StepStartTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoGuardrailStatus.
-func (in *NemoGuardrailStatus) DeepCopy() *NemoGuardrailStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NemoGuardrailStatus)
+	out := new(RolloutStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentVolumeClaim) DeepCopyInto(out *PersistentVolumeClaim) {
+func (in *S3Source) DeepCopyInto(out *S3Source) {
 	*out = *in
-	if in.Create != nil {
-		in, out := &in.Create, &out.Create
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaim.
-func (in *PersistentVolumeClaim) DeepCopy() *PersistentVolumeClaim {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Source.
+func (in *S3Source) DeepCopy() *S3Source {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentVolumeClaim)
+	out := new(S3Source)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Probe) DeepCopyInto(out *Probe) {
+func (in *S3Status) DeepCopyInto(out *S3Status) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Probe != nil {
-		in, out := &in.Probe, &out.Probe
-		*out = new(v1.Probe)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
-func (in *Probe) DeepCopy() *Probe {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Status.
+func (in *S3Status) DeepCopy() *S3Status {
 	if in == nil {
 		return nil
 	}
-	out := new(Probe)
+	out := new(S3Status)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Resources) DeepCopyInto(out *Resources) {
+func (in *ScaleToZeroSpec) DeepCopyInto(out *ScaleToZeroSpec) {
 	*out = *in
-	out.CPU = in.CPU.DeepCopy()
-	out.Memory = in.Memory.DeepCopy()
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.Activator.DeepCopyInto(&out.Activator)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
-func (in *Resources) DeepCopy() *Resources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleToZeroSpec.
+func (in *ScaleToZeroSpec) DeepCopy() *ScaleToZeroSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Resources)
+	out := new(ScaleToZeroSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1388,3 +2616,96 @@ func (in *ServiceMonitor) DeepCopy() *ServiceMonitor {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SmokeTest) DeepCopyInto(out *SmokeTest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SmokeTest.
+func (in *SmokeTest) DeepCopy() *SmokeTest {
+	if in == nil {
+		return nil
+	}
+	out := new(SmokeTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuspensionStatus) DeepCopyInto(out *SuspensionStatus) {
+	*out = *in
+	if in.SuspendedAt != nil {
+		in, out := &in.SuspendedAt, &out.SuspendedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastActivityTime != nil {
+		in, out := &in.LastActivityTime, &out.LastActivityTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuspensionStatus.
+func (in *SuspensionStatus) DeepCopy() *SuspensionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SuspensionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLS) DeepCopyInto(out *TLS) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManagerCertificate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLS.
+func (in *TLS) DeepCopy() *TLS {
+	if in == nil {
+		return nil
+	}
+	out := new(TLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationSpec) DeepCopyInto(out *VerificationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationSpec.
+func (in *VerificationSpec) DeepCopy() *VerificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeClaimTemplate) DeepCopyInto(out *VolumeClaimTemplate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeClaimTemplate.
+func (in *VolumeClaimTemplate) DeepCopy() *VolumeClaimTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeClaimTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -28,6 +28,7 @@ import (
 type AppsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	NIMCachesGetter
+	NIMOperatorConfigsGetter
 	NIMPipelinesGetter
 	NIMServicesGetter
 	NemoDatastoresGetter
@@ -43,6 +44,10 @@ func (c *AppsV1alpha1Client) NIMCaches(namespace string) NIMCacheInterface {
 	return newNIMCaches(c, namespace)
 }
 
+func (c *AppsV1alpha1Client) NIMOperatorConfigs() NIMOperatorConfigInterface {
+	return newNIMOperatorConfigs(c)
+}
+
 func (c *AppsV1alpha1Client) NIMPipelines(namespace string) NIMPipelineInterface {
 	return newNIMPipelines(c, namespace)
 }
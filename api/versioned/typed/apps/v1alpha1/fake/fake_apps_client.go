@@ -31,6 +31,10 @@ func (c *FakeAppsV1alpha1) NIMCaches(namespace string) v1alpha1.NIMCacheInterfac
 	return &FakeNIMCaches{c, namespace}
 }
 
+func (c *FakeAppsV1alpha1) NIMOperatorConfigs() v1alpha1.NIMOperatorConfigInterface {
+	return &FakeNIMOperatorConfigs{c}
+}
+
 func (c *FakeAppsV1alpha1) NIMPipelines(namespace string) v1alpha1.NIMPipelineInterface {
 	return &FakeNIMPipelines{c, namespace}
 }
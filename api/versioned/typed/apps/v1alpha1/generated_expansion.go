@@ -19,6 +19,8 @@ package v1alpha1
 
 type NIMCacheExpansion interface{}
 
+type NIMOperatorConfigExpansion interface{}
+
 type NIMPipelineExpansion interface{}
 
 type NIMServiceExpansion interface{}
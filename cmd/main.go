@@ -18,17 +18,26 @@ package main
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"flag"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	monitoring "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -36,12 +45,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/activator"
 	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
 	"github.com/NVIDIA/k8s-nim-operator/internal/controller"
 	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform"
 	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/kserve"
 	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/standalone"
+	"github.com/NVIDIA/k8s-nim-operator/internal/modelpuller"
 	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -64,6 +76,16 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var platformType string
+	var activatorNIMService string
+	var activatorBindAddress string
+	var activatorTarget string
+	var activatorWakeTimeout time.Duration
+	var activatorTLSDir string
+	var cacheSource string
+	var cachePath string
+	var verifyChecksums string
+	var verifyBlobs bool
+	var reportProgress string
 
 	flag.StringVar(&platformType, "platform", "standalone", "The model-serving inference platform to use."+
 		"E.g., 'standalone (default)', 'kserve'.")
@@ -77,6 +99,23 @@ func main() {
 		"If set the metrics endpoint is served securely")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&activatorNIMService, "activator-nimservice", "", "Run as the activator of the given "+
+		"<namespace>/<name> NIMService instead of the operator.")
+	flag.StringVar(&activatorBindAddress, "activator-bind-address", ":8000", "The address the activator serves requests on.")
+	flag.StringVar(&activatorTarget, "activator-target", "", "The URL the activator forwards requests to once the NIMService is ready.")
+	flag.DurationVar(&activatorWakeTimeout, "activator-wake-timeout", 10*time.Minute,
+		"The maximum time the activator holds requests while the NIMService resumes.")
+	flag.StringVar(&activatorTLSDir, "activator-tls-dir", "", "The directory of the serving certificate of the "+
+		"NIMService, the activator serves requests over TLS with it and trusts it to forward them.")
+	flag.StringVar(&cacheSource, "cache-source", "", "Run as the model puller of the given JSON encoded "+
+		"NIMCache source instead of the operator.")
+	flag.StringVar(&cachePath, "cache-path", "/model-store", "The path the model puller caches the model in.")
+	flag.StringVar(&verifyChecksums, "verify-checksums", "", "Run as the verifier of the cache path against the "+
+		"checksums files of the given directory instead of the operator.")
+	flag.BoolVar(&verifyBlobs, "verify-blobs", false, "Verify the blobs of the cache path against their content "+
+		"addressed names as well.")
+	flag.StringVar(&reportProgress, "report-progress", "", "Run as the reporter of the caching progress of the given "+
+		"JSON encoded profiles in the cache path instead of the operator.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -85,6 +124,26 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if activatorNIMService != "" {
+		runActivator(activatorNIMService, activatorBindAddress, activatorTarget, activatorTLSDir, activatorWakeTimeout)
+		return
+	}
+
+	if cacheSource != "" {
+		runModelPuller(cacheSource, cachePath)
+		return
+	}
+
+	if verifyChecksums != "" {
+		runVerifier(verifyChecksums, cachePath, verifyBlobs)
+		return
+	}
+
+	if reportProgress != "" {
+		runProgressReporter(reportProgress, cachePath)
+		return
+	}
+
 	var platformImpl platform.Platform
 	switch platformType {
 	case "standalone":
@@ -216,3 +275,110 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// runActivator serves requests for a suspended NIMService until it is resumed
+func runActivator(nimService, bindAddress, target, tlsDir string, wakeTimeout time.Duration) {
+	log := ctrl.Log.WithName("activator")
+	namespace, name, found := strings.Cut(nimService, "/")
+	if !found {
+		log.Error(nil, "invalid NIMService, expected <namespace>/<name>", "nimservice", nimService)
+		os.Exit(1)
+	}
+	targetURL, err := url.Parse(target)
+	if err != nil || targetURL.Host == "" {
+		log.Error(err, "invalid activator target", "target", target)
+		os.Exit(1)
+	}
+	var rootCAs *x509.CertPool
+	var certFile, keyFile string
+	if tlsDir != "" {
+		certFile = filepath.Join(tlsDir, corev1.TLSCertKey)
+		keyFile = filepath.Join(tlsDir, corev1.TLSPrivateKeyKey)
+		data := map[string][]byte{}
+		for _, key := range []string{utils.TLSCAKey, corev1.TLSCertKey} {
+			if content, err := os.ReadFile(filepath.Join(tlsDir, key)); err == nil {
+				data[key] = content
+			}
+		}
+		rootCAs, err = utils.NewCertPool(string(utils.GetServingCA(data)))
+		if err != nil {
+			log.Error(err, "invalid serving certificate", "dir", tlsDir)
+			os.Exit(1)
+		}
+	}
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		log.Error(err, "unable to create client")
+		os.Exit(1)
+	}
+
+	log.Info("starting activator", "nimservice", nimService, "address", bindAddress)
+	a := activator.NewActivator(c, types.NamespacedName{Namespace: namespace, Name: name}, targetURL, rootCAs, wakeTimeout)
+	if err := a.Start(ctrl.SetupSignalHandler(), bindAddress, certFile, keyFile); err != nil {
+		log.Error(err, "problem running activator")
+		os.Exit(1)
+	}
+}
+
+// runModelPuller caches the model of a NIMCache source and reports the result in the termination message
+func runModelPuller(cacheSource, cachePath string) {
+	log := ctrl.Log.WithName("modelpuller")
+	source := &appsv1alpha1.NIMSource{}
+	if err := json.Unmarshal([]byte(cacheSource), source); err != nil {
+		log.Error(err, "invalid NIMCache source", "source", cacheSource)
+		os.Exit(1)
+	}
+
+	log.Info("starting model puller", "path", cachePath)
+	ctx := ctrl.LoggerInto(ctrl.SetupSignalHandler(), log)
+	report, err := modelpuller.Pull(ctx, source, cachePath)
+	if err != nil {
+		log.Error(err, "problem pulling the model")
+		os.Exit(1)
+	}
+	if err := modelpuller.WriteReport(report, modelpuller.TerminationMessagePath); err != nil {
+		log.Error(err, "unable to write the model puller report")
+	}
+}
+
+// runVerifier verifies the checksums of the cached files and reports the result in the termination message
+func runVerifier(checksumsPath, cachePath string, verifyBlobs bool) {
+	log := ctrl.Log.WithName("verifier")
+	log.Info("starting verifier", "path", cachePath, "checksums", checksumsPath, "blobs", verifyBlobs)
+	ctx := ctrl.LoggerInto(ctrl.SetupSignalHandler(), log)
+	results, err := modelpuller.Verify(ctx, checksumsPath, cachePath)
+	if err != nil {
+		log.Error(err, "problem verifying the cache")
+		os.Exit(1)
+	}
+	if verifyBlobs {
+		result, err := modelpuller.VerifyBlobs(ctx, cachePath)
+		if err != nil {
+			log.Error(err, "problem verifying the cache blobs")
+			os.Exit(1)
+		}
+		if result.Files > 0 {
+			result.Profile = "ngc"
+			results = append(results, *result)
+		}
+	}
+	if err := modelpuller.WriteReport(&modelpuller.Report{Verification: results}, modelpuller.TerminationMessagePath); err != nil {
+		log.Error(err, "unable to write the verifier report")
+	}
+}
+
+// runProgressReporter reports the caching progress of the profiles in its log until the caching job completes
+func runProgressReporter(reportProgress, cachePath string) {
+	log := ctrl.Log.WithName("progress")
+	var targets []modelpuller.ProgressTarget
+	if err := json.Unmarshal([]byte(reportProgress), &targets); err != nil {
+		log.Error(err, "invalid profiles", "profiles", reportProgress)
+		os.Exit(1)
+	}
+
+	log.Info("starting progress reporter", "path", cachePath, "interval", modelpuller.ProgressInterval)
+	if err := modelpuller.ReportProgress(ctrl.SetupSignalHandler(), targets, cachePath, modelpuller.ProgressInterval, os.Stdout); err != nil {
+		log.Error(err, "problem reporting the caching progress")
+		os.Exit(1)
+	}
+}
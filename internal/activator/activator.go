@@ -0,0 +1,192 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// ForwardedHeader marks requests proxied by the activator to detect routing loops
+	ForwardedHeader = "X-Nim-Activator"
+	// wakeRequestInterval is the minimum interval between wake requests to the operator
+	wakeRequestInterval = 10 * time.Second
+	// pollInterval is the interval to check whether the NIMService has resumed
+	pollInterval = 2 * time.Second
+)
+
+// Activator holds requests for a suspended NIMService, requests the operator to resume it and
+// forwards the requests once it is ready
+type Activator struct {
+	client      client.Client
+	nimService  types.NamespacedName
+	wakeTimeout time.Duration
+	proxy       *httputil.ReverseProxy
+
+	mu              sync.Mutex
+	lastWakeRequest time.Time
+}
+
+// NewActivator returns an activator for the given NIMService forwarding requests to the given target, the given CAs
+// are trusted instead of the system ones when set
+func NewActivator(c client.Client, nimService types.NamespacedName, target *url.URL, rootCAs *x509.CertPool, wakeTimeout time.Duration) *Activator {
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	if rootCAs != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+		proxy.Transport = transport
+	}
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.Header.Set(ForwardedHeader, "1")
+	}
+	return &Activator{
+		client:      c,
+		nimService:  nimService,
+		wakeTimeout: wakeTimeout,
+		proxy:       proxy,
+	}
+}
+
+// ServeHTTP resumes the NIMService and forwards the request once it is ready
+func (a *Activator) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	logger := log.FromContext(req.Context())
+
+	// The service still routes to the activator, let the client retry
+	if req.Header.Get(ForwardedHeader) != "" {
+		w.Header().Set("Retry-After", "5")
+		http.Error(w, "NIMService is resuming", http.StatusServiceUnavailable)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), a.wakeTimeout)
+	defer cancel()
+	if err := a.wake(ctx); err != nil {
+		logger.Error(err, "failed to resume NIMService", "nimservice", a.nimService)
+		w.Header().Set("Retry-After", "30")
+		http.Error(w, fmt.Sprintf("NIMService %s is not ready: %v", a.nimService.Name, err), http.StatusServiceUnavailable)
+		return
+	}
+	a.proxy.ServeHTTP(w, req)
+}
+
+// wake requests the operator to resume the NIMService and waits until it is ready
+func (a *Activator) wake(ctx context.Context) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		nimService := &appsv1alpha1.NIMService{}
+		if err := a.client.Get(ctx, a.nimService, nimService); err != nil {
+			return err
+		}
+		if !nimService.IsSuspended() && meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Ready) {
+			return nil
+		}
+		if nimService.Spec.Suspend {
+			return errors.New("NIMService is suspended")
+		}
+		if nimService.IsSuspended() {
+			if err := a.requestWake(ctx, nimService); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// requestWake annotates the NIMService with the time of the request, rate limited across concurrent requests
+func (a *Activator) requestWake(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if time.Since(a.lastWakeRequest) < wakeRequestInterval {
+		return nil
+	}
+
+	patch := client.MergeFrom(nimService.DeepCopy())
+	annotations := nimService.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[appsv1alpha1.NIMServiceWakeAnnotationKey] = time.Now().UTC().Format(time.RFC3339)
+	nimService.SetAnnotations(annotations)
+	if err := a.client.Patch(ctx, nimService, patch); err != nil {
+		return err
+	}
+	a.lastWakeRequest = time.Now()
+	log.FromContext(ctx).Info("Requested to resume NIMService", "nimservice", a.nimService)
+	return nil
+}
+
+// Start serves requests on the given address until the context is cancelled, over TLS when the certificate and key
+// files are set. The files are loaded on each handshake to serve the renewed certificates
+func (a *Activator) Start(ctx context.Context, addr string, certFile string, keyFile string) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           a,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	if certFile != "" {
+		server.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+				cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+				if err != nil {
+					return nil, err
+				}
+				return &cert, nil
+			},
+		}
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	var err error
+	if server.TLSConfig != nil {
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestForwardTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get(ForwardedHeader)))
+	}))
+	defer server.Close()
+	target, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, appsv1alpha1.AddToScheme(scheme))
+	nimService := &appsv1alpha1.NIMService{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-nimservice", Namespace: "default"},
+		Status: appsv1alpha1.NIMServiceStatus{
+			Conditions: []metav1.Condition{{Type: conditions.Ready, Status: metav1.ConditionTrue}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(nimService).Build()
+	namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+
+	// The serving certificate of the target is not trusted
+	recorder := httptest.NewRecorder()
+	NewActivator(c, namespacedName, target, nil, time.Second).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/models", nil))
+	assert.Equal(t, http.StatusBadGateway, recorder.Code)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	recorder = httptest.NewRecorder()
+	NewActivator(c, namespacedName, target, rootCAs, time.Second).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/models", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "1", recorder.Body.String())
+}
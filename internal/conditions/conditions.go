@@ -35,6 +35,10 @@ const (
 	NotReady = "NotReady"
 	// Failed indicates that the service has failed
 	Failed = "Failed"
+	// GatewayRouteAccepted indicates that the Gateway API routes of the service are accepted by their Gateways
+	GatewayRouteAccepted = "GatewayRouteAccepted"
+	// GatewayRouteResolvedRefs indicates that the backends of the Gateway API routes are resolved
+	GatewayRouteResolvedRefs = "GatewayRouteResolvedRefs"
 	// ReasonServiceAccountFailed indicates that the creation of serviceaccount has failed
 	ReasonServiceAccountFailed = "ServiceAccountFailed"
 	// ReasonRoleFailed indicates that the creation of serviceaccount has failed
@@ -47,6 +51,16 @@ const (
 	ReasonIngressFailed = "IngressFailed"
 	// ReasonHPAFailed indicates that the creation of hpa has failed
 	ReasonHPAFailed = "HPAFailed"
+	// ReasonPDBFailed indicates that the creation of the pod disruption budget has failed
+	ReasonPDBFailed = "PDBFailed"
+	// ReasonNetworkPolicyFailed indicates that the creation of the network policy has failed
+	ReasonNetworkPolicyFailed = "NetworkPolicyFailed"
+	// ReasonGatewayRouteFailed indicates that the creation of the Gateway API routes has failed
+	ReasonGatewayRouteFailed = "GatewayRouteFailed"
+	// ReasonCertificateFailed indicates that the creation of the TLS certificate has failed
+	ReasonCertificateFailed = "CertificateFailed"
+	// ReasonGatewayRoutePending indicates that the Gateways have not reported the route status yet
+	ReasonGatewayRoutePending = "Pending"
 	// ReasonSCCFailed indicates that the creation of scc has failed
 	ReasonSCCFailed = "SCCFailed"
 	// ReasonServiceMonitorFailed indicates that the creation of Service Monitor has failed
@@ -55,6 +69,18 @@ const (
 	ReasonDeploymentFailed = "DeploymentFailed"
 	// ReasonStatefulSetFailed indicates that the creation of statefulset has failed
 	ReasonStatefulSetFailed = "StatefulsetFailed"
+	// ReasonLeaderWorkerSetFailed indicates that the creation of leaderworkerset has failed
+	ReasonLeaderWorkerSetFailed = "LeaderWorkerSetFailed"
+	// ReasonScaledObjectFailed indicates that the creation of the KEDA scaledobject has failed
+	ReasonScaledObjectFailed = "ScaledObjectFailed"
+	// ReasonGPUResourceUnavailable indicates that the requested GPU resource is not allocatable on any node
+	ReasonGPUResourceUnavailable = "GPUResourceUnavailable"
+	// ReasonNoCompatibleGPUNodes indicates that no node has the GPU product the selected model profile is optimized for
+	ReasonNoCompatibleGPUNodes = "NoCompatibleGPUNodes"
+	// ReasonNoCompatibleProfile indicates that no cached model profile can run on the GPUs available in the cluster
+	ReasonNoCompatibleProfile = "NoCompatibleProfile"
+	// ReasonProfileSelected indicates that a cached model profile was automatically selected
+	ReasonProfileSelected = "ProfileSelected"
 )
 
 // Updater is the condition updater
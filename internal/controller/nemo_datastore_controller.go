@@ -33,6 +33,7 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -42,6 +43,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -94,6 +96,9 @@ func NewNemoDatastoreReconciler(client client.Client, scheme *runtime.Scheme, up
 // +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalars,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -150,8 +155,16 @@ func (r *NemoDatastoreReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}
 
+	// Apply the operator defaults to the fields unset in the NemoDatastore spec, the spec is not persisted
+	operatorConfig, err := shared.GetOperatorConfig(ctx, r.GetClient())
+	if err != nil {
+		logger.Error(err, "unable to get the operator config")
+		return ctrl.Result{}, err
+	}
+	operatorConfig.ApplyToNemoDatastore(NemoDatastore)
+
 	// Fetch container orchestrator type
-	_, err := r.GetOrchestratorType()
+	_, err = r.GetOrchestratorType()
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("Unable to get container orchestrator type, %v", err)
 	}
@@ -232,6 +245,9 @@ func (r *NemoDatastoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&rbacv1.RoleBinding{}).
 		Owns(&networkingv1.Ingress{}).
 		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Watches(&appsv1alpha1.NIMOperatorConfig{}, shared.EnqueueRequestsForOperatorConfig(mgr.GetClient(), &appsv1alpha1.NemoDatastoreList{}), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		WithEventFilter(predicate.Funcs{
 			UpdateFunc: func(e event.UpdateEvent) bool {
 				// Type assert to NemoDatastore
@@ -343,6 +359,55 @@ func (r *NemoDatastoreReconciler) reconcileNemoDatastore(ctx context.Context, ne
 		}
 	}
 
+	// Sync PodDisruptionBudget
+	if nemoDatastore.IsPDBEnabled() {
+		err = r.renderAndSyncResource(ctx, nemoDatastore, &renderer, &policyv1.PodDisruptionBudget{}, func() (client.Object, error) {
+			return renderer.PodDisruptionBudget(nemoDatastore.GetPDBParams())
+		}, "pdb", conditions.ReasonPDBFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// If the disruption budget is disabled, ensure the PDB is deleted
+		err = r.cleanupResource(ctx, &policyv1.PodDisruptionBudget{}, namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync NetworkPolicy
+	if nemoDatastore.IsNetworkPolicyEnabled() {
+		err = r.renderAndSyncResource(ctx, nemoDatastore, &renderer, &networkingv1.NetworkPolicy{}, func() (client.Object, error) {
+			return renderer.NetworkPolicy(nemoDatastore.GetNetworkPolicyParams())
+		}, "networkpolicy", conditions.ReasonNetworkPolicyFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// If the network policy is disabled, ensure it is deleted
+		err = r.cleanupResource(ctx, &networkingv1.NetworkPolicy{}, namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync Gateway API routes
+	routesAccepted := true
+	if nemoDatastore.IsGatewayRouteEnabled() {
+		routesAccepted, err = shared.ReconcileGatewayRoutes(ctx, r.GetClient(), renderer, nemoDatastore.GetGatewayRouteParams(), &nemoDatastore.Status.Conditions, func(obj client.Object, renderFunc func() (client.Object, error), conditionType string) error {
+			return r.renderAndSyncResource(ctx, nemoDatastore, &renderer, obj, renderFunc, conditionType, conditions.ReasonGatewayRouteFailed)
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// If the routes are disabled, ensure they are deleted
+		err = shared.CleanupGatewayRoutes(ctx, r.GetClient(), namespacedName, &nemoDatastore.Status.Conditions)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Sync Service Monitor
 	if nemoDatastore.IsServiceMonitorEnabled() {
 		err = r.renderAndSyncResource(ctx, nemoDatastore, &renderer, &monitoringv1.ServiceMonitor{}, func() (client.Object, error) {
@@ -404,6 +469,10 @@ func (r *NemoDatastoreReconciler) reconcileNemoDatastore(ctx context.Context, ne
 		return ctrl.Result{}, err
 	}
 
+	// Poll the routes until their Gateways report them as accepted
+	if !routesAccepted {
+		return ctrl.Result{RequeueAfter: shared.GatewayRouteRequeueInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -19,18 +19,25 @@ package controller
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
+	"os"
+	"path/filepath"
 	"reflect"
-	"strings"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
 	platform "github.com/NVIDIA/k8s-nim-operator/internal/controller/platform"
+	"github.com/NVIDIA/k8s-nim-operator/internal/huggingface"
 	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/modelpuller"
 	"github.com/NVIDIA/k8s-nim-operator/internal/nimparser"
 	nimparserutils "github.com/NVIDIA/k8s-nim-operator/internal/nimparser/utils"
 	"github.com/NVIDIA/k8s-nim-operator/internal/render"
@@ -41,7 +48,9 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -51,6 +60,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -80,6 +90,9 @@ const (
 
 	// NIMCacheContainerName returns the name of the container used for NIM Cache operations.
 	NIMCacheContainerName = "nim-cache-ctr"
+
+	// NIMCacheProgressContainerName is the name of the sidecar reporting the caching progress.
+	NIMCacheProgressContainerName = "nim-cache-progress"
 )
 
 // NIMCacheReconciler reconciles a NIMCache object
@@ -117,6 +130,7 @@ func NewNIMCacheReconciler(client client.Client, scheme *runtime.Scheme, log log
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resource.k8s.io,resources=resourceclaimtemplates,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -178,6 +192,14 @@ func (r *NIMCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
+	// Apply the operator defaults to the fields unset in the NIMCache spec, the spec is not persisted
+	operatorConfig, err := shared.GetOperatorConfig(ctx, r.GetClient())
+	if err != nil {
+		logger.Error(err, "unable to get the operator config")
+		return ctrl.Result{}, err
+	}
+	operatorConfig.ApplyToNIMCache(nimCache)
+
 	// Fetch container orchestrator type
 	_, err = r.GetOrchestratorType()
 	if err != nil {
@@ -186,6 +208,14 @@ func (r *NIMCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 
 	// Handle nim-cache reconciliation
 	result, err = r.reconcileNIMCache(ctx, nimCache)
+	if err == nil {
+		// Handle platform-specific reconciliation
+		var platformResult ctrl.Result
+		platformResult, err = r.Platform.Sync(ctx, r, nimCache)
+		if result.IsZero() {
+			result = platformResult
+		}
+	}
 	if err != nil {
 		logger.Error(err, "error reconciling NIMCache", "name", nimCache.Name)
 		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionReconcileFailed, metav1.ConditionTrue, "ReconcileFailed", err.Error())
@@ -252,6 +282,7 @@ func (r *NIMCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&batchv1.Job{}).
 		Owns(&corev1.Pod{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
+		Watches(&appsv1alpha1.NIMOperatorConfig{}, shared.EnqueueRequestsForOperatorConfig(mgr.GetClient(), &appsv1alpha1.NIMCacheList{}), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		WithEventFilter(predicate.Funcs{
 			UpdateFunc: func(e event.UpdateEvent) bool {
 				// Type assert to NIMCache
@@ -279,6 +310,12 @@ func (r *NIMCacheReconciler) cleanupNIMCache(ctx context.Context, nimCache *apps
 
 	// TODO: Check if the cache is in use (allocated) and prevent deletion
 
+	// Perform platform specific cleanup of resources
+	if err := r.Platform.Delete(ctx, r, nimCache); err != nil {
+		logger.Error(err, "unable to cleanup platform resources", "nimcache", nimCache.Name)
+		return err
+	}
+
 	// All owned objects are garbage collected
 
 	// Fetch the job
@@ -548,6 +585,11 @@ func isModelSelectionDone(nimCache *appsv1alpha1.NIMCache) bool {
 }
 
 func getSelectedProfiles(nimCache *appsv1alpha1.NIMCache) ([]string, error) {
+	// Profiles are selected only for NGC model pullers
+	if nimCache.Spec.Source.NGC == nil {
+		return nil, nil
+	}
+
 	// Return profiles explicitly specified by the user in the spec
 	if len(nimCache.Spec.Source.NGC.Model.Profiles) > 0 {
 		return nimCache.Spec.Source.NGC.Model.Profiles, nil
@@ -611,7 +653,7 @@ func (r *NIMCacheReconciler) reconcileModelManifest(ctx context.Context, nimCach
 	}
 
 	// Extract manifest file
-	output, err := r.getPodLogs(ctx, existingPod)
+	output, err := r.getPodLogs(ctx, existingPod, corev1.PodLogOptions{Container: NIMCacheContainerName})
 	if err != nil {
 		logger.Error(err, "failed to get pod logs for parsing model manifest file", "pod", pod.Name)
 		return false, err
@@ -705,8 +747,18 @@ func (r *NIMCacheReconciler) reconcileJob(ctx context.Context, nimCache *appsv1a
 		return err
 	}
 
+	// Wait for the job of the previous source to be deleted before caching the new files
+	if err == nil && job.GetDeletionTimestamp() != nil {
+		logger.Info("Waiting for the previous caching job to be deleted", "job", jobName)
+		return nil
+	}
+
 	// If Job does not exist and caching is not complete, create a new one
 	if err != nil && nimCache.Status.State != appsv1alpha1.NimCacheStatusReady {
+		if err := r.reconcileResourceClaimTemplate(ctx, nimCache); err != nil {
+			logger.Error(err, "Failed to reconcile resource claim template")
+			return err
+		}
 		job, err := r.constructJob(ctx, nimCache, r.orchestratorType)
 		if err != nil {
 			logger.Error(err, "Failed to construct job")
@@ -724,6 +776,25 @@ func (r *NIMCacheReconciler) reconcileJob(ctx context.Context, nimCache *appsv1a
 		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionJobCreated, metav1.ConditionTrue, "JobCreated", "The Job to cache NIM has been created")
 		nimCache.Status.State = appsv1alpha1.NimCacheStatusStarted
 		nimCache.Status.Profiles = []v1alpha1.NIMProfile{}
+		// The new cache is verified again once cached
+		meta.RemoveStatusCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified)
+		nimCache.Status.Verification = nil
+		// The profiles are pending until the progress sidecar measures them
+		nimCache.Status.Progress = nil
+		nimCache.Status.TotalBytes = 0
+		if nimCache.IsProgressEnabled() {
+			targets, err := r.getProgressTargets(ctx, nimCache)
+			if err != nil {
+				return err
+			}
+			for _, target := range targets {
+				nimCache.Status.Progress = append(nimCache.Status.Progress, appsv1alpha1.ProfileProgress{
+					Name:       target.Name,
+					State:      appsv1alpha1.ProfileProgressPending,
+					TotalBytes: target.TotalBytes,
+				})
+			}
+		}
 		return nil
 	}
 
@@ -735,6 +806,410 @@ func (r *NIMCacheReconciler) reconcileJob(ctx context.Context, nimCache *appsv1a
 	return nil
 }
 
+// reconcileResourceClaimTemplate creates the ResourceClaimTemplate of the caching job GPUs when it is generated by the operator
+func (r *NIMCacheReconciler) reconcileResourceClaimTemplate(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
+	if !nimCache.IsDRAEnabled() || nimCache.Spec.DRA.ResourceClaimTemplateName != "" {
+		return nil
+	}
+
+	name := nimCache.GetResourceClaimTemplateName()
+	existing := &resourcev1alpha3.ResourceClaimTemplate{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: nimCache.GetNamespace()}, existing)
+	if err == nil || client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	var count int64 = 1
+	var product string
+	if nimCache.Spec.Source.NGC != nil {
+		model := nimCache.Spec.Source.NGC.Model
+		if tp, err := strconv.ParseInt(model.TensorParallelism, 10, 64); err == nil {
+			count = tp
+		}
+		if len(model.GPUs) > 0 {
+			product = model.GPUs[0].Product
+		}
+	}
+
+	template := shared.ConstructResourceClaimTemplate(*nimCache.Spec.DRA, count, product, metav1.ObjectMeta{Name: name, Namespace: nimCache.GetNamespace()})
+	if err := controllerutil.SetControllerReference(nimCache, template, r.GetScheme()); err != nil {
+		return err
+	}
+	return r.Create(ctx, template)
+}
+
+// reconcileHuggingFace resolves the revision of the Hugging Face repository to a commit and lists the files to cache,
+// the caching job is pinned to the resolved commit
+func (r *NIMCacheReconciler) reconcileHuggingFace(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
+	source := nimCache.Spec.Source.HuggingFace
+	if source == nil {
+		return nil
+	}
+	sourceHash, err := getSourceHash(source)
+	if err != nil {
+		return err
+	}
+	if nimCache.Status.HuggingFace != nil && nimCache.Status.HuggingFace.SourceHash == sourceHash {
+		return nil
+	}
+
+	token, err := r.getHuggingFaceToken(ctx, nimCache)
+	if err != nil {
+		return err
+	}
+	rootCAs, err := r.getCertPool(ctx, nimCache)
+	if err != nil {
+		return err
+	}
+	info, err := huggingface.NewClient(source.GetEndpoint(), token, rootCAs).GetRepoInfo(ctx, source.RepoID, source.GetRevision())
+	if err != nil {
+		return err
+	}
+	files, err := info.FilterFiles(source.Include, source.Exclude)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no files of model repository %s match the include and exclude patterns", source.RepoID)
+	}
+
+	r.GetLogger().Info("Resolved hugging face revision", "repo", source.RepoID, "revision", source.GetRevision(), "commit", info.SHA)
+	if previous := nimCache.Status.HuggingFace; previous != nil && (previous.Revision != info.SHA || !reflect.DeepEqual(previous.Files, files)) {
+		if err := r.resetCache(ctx, nimCache); err != nil {
+			return err
+		}
+	}
+	nimCache.Status.HuggingFace = &appsv1alpha1.HuggingFaceStatus{
+		RepoID:     source.RepoID,
+		Revision:   info.SHA,
+		Files:      files,
+		Size:       info.GetFilesSize(files),
+		SourceHash: sourceHash,
+	}
+	return nil
+}
+
+// resetCache deletes the caching and verification jobs once the source resolves to other files, so that the model
+// is cached and verified again. The profiles, verification and progress of the previous files are reset
+func (r *NIMCacheReconciler) resetCache(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
+	for _, name := range []string{getJobName(nimCache), nimCache.GetVerificationJobName()} {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: nimCache.GetNamespace()}}
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete job %s: %w", name, err)
+		}
+	}
+	r.GetLogger().Info("Source changed, caching the model again", "job", getJobName(nimCache))
+	meta.RemoveStatusCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionJobCompleted)
+	meta.RemoveStatusCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified)
+	nimCache.Status.State = appsv1alpha1.NimCacheStatusNotReady
+	nimCache.Status.Profiles = []v1alpha1.NIMProfile{}
+	nimCache.Status.Verification = nil
+	nimCache.Status.Progress = nil
+	nimCache.Status.TotalBytes = 0
+	return nil
+}
+
+// getSourceHash returns the hash of the source spec, to resolve the source again when any of its fields changes
+func getSourceHash(source interface{}) (string, error) {
+	data, err := json.Marshal(source)
+	if err != nil {
+		return "", err
+	}
+	return utils.GetStringHash(string(data)), nil
+}
+
+// getHuggingFaceToken returns the Hugging Face token of the auth secret, empty for anonymous access
+func (r *NIMCacheReconciler) getHuggingFaceToken(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (string, error) {
+	source := nimCache.Spec.Source.HuggingFace
+	if source.AuthSecret == "" {
+		return "", nil
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: source.AuthSecret, Namespace: nimCache.GetNamespace()}, secret); err != nil {
+		return "", fmt.Errorf("failed to get hugging face auth secret %s: %w", source.AuthSecret, err)
+	}
+	return string(secret.Data[huggingface.TokenEnv]), nil
+}
+
+// reconcileOCI resolves the reference of the OCI artifact to the digest of its manifest, the caching job is pinned to the digest
+func (r *NIMCacheReconciler) reconcileOCI(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
+	source := nimCache.Spec.Source.OCI
+	if source == nil {
+		return nil
+	}
+	sourceHash, err := getSourceHash(source)
+	if err != nil {
+		return err
+	}
+	if nimCache.Status.OCI != nil && nimCache.Status.OCI.SourceHash == sourceHash {
+		return nil
+	}
+
+	credentials, err := r.getOCICredentials(ctx, nimCache)
+	if err != nil {
+		return err
+	}
+	rootCAs, err := r.getCertPool(ctx, nimCache)
+	if err != nil {
+		return err
+	}
+	status, err := modelpuller.ResolveOCI(ctx, source, credentials, rootCAs)
+	if err != nil {
+		return err
+	}
+	r.GetLogger().Info("Resolved oci digest", "reference", source.Reference, "digest", status.Digest)
+	if previous := nimCache.Status.OCI; previous != nil && previous.Digest != status.Digest {
+		if err := r.resetCache(ctx, nimCache); err != nil {
+			return err
+		}
+	}
+	status.SourceHash = sourceHash
+	nimCache.Status.OCI = status
+	return nil
+}
+
+// getOCICredentials returns the credentials for the registry of the OCI artifact from the first matching pull secret
+func (r *NIMCacheReconciler) getOCICredentials(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (*modelpuller.RegistryCredentials, error) {
+	source := nimCache.Spec.Source.OCI
+	registry, err := modelpuller.GetOCIRegistry(source.Reference)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range source.PullSecrets {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: nimCache.GetNamespace()}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get oci pull secret %s: %w", name, err)
+		}
+		credentials, err := modelpuller.DockerConfigCredentials(secret.Data[corev1.DockerConfigJsonKey], registry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid oci pull secret %s: %w", name, err)
+		}
+		if credentials != nil {
+			return credentials, nil
+		}
+	}
+	return nil, nil
+}
+
+// getS3Credentials returns the credentials of the S3 credentials secret, the objects are read anonymously without it
+func (r *NIMCacheReconciler) getS3Credentials(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (*modelpuller.S3Credentials, error) {
+	name := nimCache.Spec.Source.S3.CredentialsSecret
+	if name == "" {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: nimCache.GetNamespace()}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get s3 credentials secret %s: %w", name, err)
+	}
+	return &modelpuller.S3Credentials{
+		AccessKey:    string(secret.Data["AWS_ACCESS_KEY_ID"]),
+		SecretKey:    string(secret.Data["AWS_SECRET_ACCESS_KEY"]),
+		SessionToken: string(secret.Data["AWS_SESSION_TOKEN"]),
+	}, nil
+}
+
+// reconcileVerification verifies the checksums of the cached files with a verification job once the caching job
+// completes, the result is reported in the verified condition
+func (r *NIMCacheReconciler) reconcileVerification(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
+	logger := r.GetLogger()
+	if !nimCache.IsVerificationEnabled() || nimCache.Status.State != appsv1alpha1.NimCacheStatusReady {
+		return nil
+	}
+	condition := meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified)
+	if condition != nil && condition.Status != metav1.ConditionUnknown {
+		return nil
+	}
+
+	job := &batchv1.Job{}
+	jobName := types.NamespacedName{Name: nimCache.GetVerificationJobName(), Namespace: nimCache.GetNamespace()}
+	err := r.Get(ctx, jobName, job)
+	if err != nil && client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	if err != nil {
+		created, err := r.reconcileChecksums(ctx, nimCache)
+		if err != nil {
+			return err
+		}
+		if !created {
+			conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified, metav1.ConditionFalse, "NoChecksums",
+				"The source provides no checksums to verify the cached files against, set the checksums ConfigMap")
+			return nil
+		}
+
+		job, err := constructVerificationJob(nimCache, r.orchestratorType)
+		if err != nil {
+			return err
+		}
+		if err := controllerutil.SetControllerReference(nimCache, job, r.GetScheme()); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create verification job")
+			return err
+		}
+		logger.Info("Created Job to verify NIM Cache", "job", jobName)
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified, metav1.ConditionUnknown, "VerificationInProgress", "The Job to verify the cached files has been created")
+		nimCache.Status.Verification = nil
+		return nil
+	}
+
+	switch {
+	case job.Status.Succeeded > 0:
+		report, err := r.getModelPullerReport(ctx, job)
+		if err != nil {
+			return fmt.Errorf("failed to get the verification report: %w", err)
+		}
+		nimCache.Status.Verification = report.Verification
+
+		var files, failed int64
+		for _, result := range report.Verification {
+			files += result.Files
+			failed += result.Files - result.VerifiedFiles
+		}
+		if files == 0 {
+			logger.Info("No cached files to verify", "job", jobName)
+			conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified, metav1.ConditionFalse, "NoChecksums",
+				"The cache has no files with a checksum to verify, set the checksums ConfigMap")
+			return nil
+		}
+		if failed > 0 {
+			logger.Info("Cached files do not match their checksum", "job", jobName, "failed", failed)
+			conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified, metav1.ConditionFalse, "ChecksumMismatch",
+				fmt.Sprintf("%d of %d cached files are missing or do not match their checksum", failed, files))
+			return nil
+		}
+		logger.Info("Cached files verified", "job", jobName, "files", files)
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified, metav1.ConditionTrue, "Verified",
+			fmt.Sprintf("The checksums of the %d cached files are verified", files))
+
+	case job.Status.Failed > 0:
+		logger.Info("Failed to verify NIM cache, job failed", "job", jobName)
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified, metav1.ConditionFalse, "VerificationFailed", "The Job to verify the cached files has failed")
+	}
+	return nil
+}
+
+// reconcileChecksums ensures the checksums ConfigMap of the verification job exists, generating it from the checksums
+// provided by the source unless set by the user, and returns false when there are no checksums to verify
+func (r *NIMCacheReconciler) reconcileChecksums(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (bool, error) {
+	name := nimCache.GetChecksumsConfigMapName()
+	if nimCache.Spec.Verification.Checksums != "" {
+		if _, err := r.getConfigMap(ctx, name, nimCache.GetNamespace()); err != nil {
+			return false, fmt.Errorf("failed to get checksums ConfigMap %s: %w", name, err)
+		}
+		return true, nil
+	}
+	// The verifier checks the blobs of NGC caches against their content addressed names
+	if nimCache.Spec.Source.NGC != nil {
+		return true, nil
+	}
+
+	data := map[string]string{}
+	switch {
+	case nimCache.Spec.Source.HuggingFace != nil && nimCache.Status.HuggingFace != nil:
+		checksums, err := r.getHuggingFaceChecksums(ctx, nimCache)
+		if err != nil {
+			return false, err
+		}
+		if len(checksums) > 0 {
+			data["huggingface"] = modelpuller.FormatChecksums(checksums)
+		}
+	case nimCache.Spec.Source.OCI != nil && nimCache.Status.OCI != nil:
+		source, _, _, err := getModelPullerSource(nimCache)
+		if err != nil {
+			return false, err
+		}
+		credentials, err := r.getOCICredentials(ctx, nimCache)
+		if err != nil {
+			return false, err
+		}
+		rootCAs, err := r.getCertPool(ctx, nimCache)
+		if err != nil {
+			return false, err
+		}
+		checksums, err := modelpuller.GetOCIChecksums(ctx, source.OCI, credentials, rootCAs)
+		if err != nil {
+			return false, err
+		}
+		if len(checksums) > 0 {
+			data["oci"] = modelpuller.FormatChecksums(checksums)
+		}
+	case nimCache.Spec.Source.S3 != nil:
+		credentials, err := r.getS3Credentials(ctx, nimCache)
+		if err != nil {
+			return false, err
+		}
+		rootCAs, err := r.getCertPool(ctx, nimCache)
+		if err != nil {
+			return false, err
+		}
+		checksums, err := modelpuller.GetS3Checksums(ctx, nimCache.Spec.Source.S3, credentials, rootCAs)
+		if err != nil {
+			return false, err
+		}
+		if len(checksums) > 0 {
+			data["s3"] = modelpuller.FormatChecksums(checksums)
+		}
+	}
+	if len(data) == 0 {
+		return false, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: nimCache.GetNamespace()}, configMap)
+	if err != nil && client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+	if err == nil {
+		configMap.Data = data
+		return true, r.Update(ctx, configMap)
+	}
+
+	configMap = &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: nimCache.GetNamespace(),
+			Labels: map[string]string{
+				"app": nimCache.GetName(),
+			},
+		},
+		Data: data,
+	}
+	if err := controllerutil.SetControllerReference(nimCache, configMap, r.GetScheme()); err != nil {
+		return false, err
+	}
+	if err := r.Create(ctx, configMap); err != nil {
+		return false, fmt.Errorf("failed to create checksums ConfigMap %s: %w", name, err)
+	}
+	return true, nil
+}
+
+// getHuggingFaceChecksums returns the checksums the Hub serves as ETag of the cached files at the resolved commit
+func (r *NIMCacheReconciler) getHuggingFaceChecksums(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (map[string]string, error) {
+	source := nimCache.Spec.Source.HuggingFace
+	token, err := r.getHuggingFaceToken(ctx, nimCache)
+	if err != nil {
+		return nil, err
+	}
+	rootCAs, err := r.getCertPool(ctx, nimCache)
+	if err != nil {
+		return nil, err
+	}
+	info, err := huggingface.NewClient(source.GetEndpoint(), token, rootCAs).GetRepoInfo(ctx, source.RepoID, nimCache.Status.HuggingFace.Revision)
+	if err != nil {
+		return nil, err
+	}
+	checksums := map[string]string{}
+	for _, sibling := range info.Siblings {
+		if checksum := sibling.Checksum(); checksum != "" && utils.ContainsElement(nimCache.Status.HuggingFace.Files, sibling.RFilename) {
+			checksums[sibling.RFilename] = checksum
+		}
+	}
+	return checksums, nil
+}
+
 func (r *NIMCacheReconciler) reconcileJobStatus(ctx context.Context, nimCache *appsv1alpha1.NIMCache, job *batchv1.Job) error {
 	logger := log.FromContext(ctx)
 	jobName := job.Name
@@ -746,6 +1221,16 @@ func (r *NIMCacheReconciler) reconcileJobStatus(ctx context.Context, nimCache *a
 		nimCache.Status.State = appsv1alpha1.NimCacheStatusReady
 		nimCache.Status.PVC = getPvcName(nimCache, nimCache.Spec.Storage.PVC)
 
+		// Report the objects synced by the model puller
+		if nimCache.Spec.Source.S3 != nil {
+			report, err := r.getModelPullerReport(ctx, job)
+			if err != nil {
+				logger.Error(err, "unable to get the model puller report", "job", jobName)
+			} else {
+				nimCache.Status.S3 = report.S3
+			}
+		}
+
 		selectedProfiles, err := getSelectedProfiles(nimCache)
 		if err != nil {
 			return fmt.Errorf("failed to get selected profiles: %w", err)
@@ -795,44 +1280,223 @@ func (r *NIMCacheReconciler) reconcileJobStatus(ctx context.Context, nimCache *a
 
 	}
 
-	return nil
-}
-
-func (r *NIMCacheReconciler) createPod(ctx context.Context, pod *corev1.Pod) error {
-	// Create pod
-	err := r.Create(ctx, pod)
-	if err != nil && !errors.IsAlreadyExists(err) {
-		return err
+	// Report the caching progress measured by the sidecar
+	if nimCache.IsProgressEnabled() {
+		r.reconcileProgress(ctx, nimCache, job)
 	}
+
 	return nil
 }
 
-func (r *NIMCacheReconciler) reconcileNIMCache(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (ctrl.Result, error) {
-	logger := r.GetLogger()
-	defer r.refreshMetrics(ctx)
+// reconcileProgress updates the caching progress of the profiles from the last progress logged by the sidecar,
+// the profiles are complete or failed once the caching job is
+func (r *NIMCacheReconciler) reconcileProgress(ctx context.Context, nimCache *appsv1alpha1.NIMCache, job *batchv1.Job) {
+	logger := log.FromContext(ctx)
 
-	// Reconcile ServiceAccount
-	err := r.reconcileServiceAccount(ctx, nimCache)
-	if err != nil {
-		logger.Error(err, "reconciliation of serviceaccount failed")
-		return ctrl.Result{}, err
+	var state appsv1alpha1.ProfileProgressState
+	switch nimCache.Status.State {
+	case appsv1alpha1.NimCacheStatusReady:
+		state = appsv1alpha1.ProfileProgressComplete
+	case appsv1alpha1.NimCacheStatusFailed:
+		state = appsv1alpha1.ProfileProgressFailed
 	}
-
-	// Reconcile Role
-	err = r.reconcileRole(ctx, nimCache)
-	if err != nil {
-		logger.Error(err, "reconciliation of role failed")
-		return ctrl.Result{}, err
+	if state != "" && isProgressFinished(nimCache.Status.Progress) {
+		return
 	}
 
-	// Reconcile RoleBinding
-	err = r.reconcileRoleBinding(ctx, nimCache)
+	progress, err := r.getProgress(ctx, job)
 	if err != nil {
-		logger.Error(err, "reconciliation of rolebinding failed")
-		return ctrl.Result{}, err
+		logger.V(2).Info("caching progress not available", "job", job.GetName(), "error", err.Error())
+	} else {
+		updateProgress(&nimCache.Status, progress)
+	}
+	if state == "" {
+		return
 	}
 
-	// Reconcile PVC
+	finishTime := metav1.Now()
+	if state == appsv1alpha1.ProfileProgressComplete && job.Status.CompletionTime != nil {
+		finishTime = *job.Status.CompletionTime
+	}
+	for i := range nimCache.Status.Progress {
+		profile := &nimCache.Status.Progress[i]
+		if profile.State == appsv1alpha1.ProfileProgressComplete {
+			continue
+		}
+		profile.State = state
+		if profile.StartTime == nil {
+			profile.StartTime = job.Status.StartTime
+		}
+		profile.FinishTime = &finishTime
+	}
+}
+
+// isProgressFinished returns true if all the profiles are complete or failed
+func isProgressFinished(profiles []appsv1alpha1.ProfileProgress) bool {
+	for _, profile := range profiles {
+		if profile.State != appsv1alpha1.ProfileProgressComplete && profile.State != appsv1alpha1.ProfileProgressFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// getProgress returns the last caching progress logged by the sidecar of the latest job pod
+func (r *NIMCacheReconciler) getProgress(ctx context.Context, job *batchv1.Job) (*modelpuller.Progress, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(job.GetNamespace()), client.MatchingLabels{"job-name": job.GetName()}); err != nil {
+		return nil, err
+	}
+	var latest *corev1.Pod
+	for i := range pods.Items {
+		if latest == nil || latest.CreationTimestamp.Before(&pods.Items[i].CreationTimestamp) {
+			latest = &pods.Items[i]
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no pod found for job %s", job.GetName())
+	}
+
+	logs, err := r.getPodLogs(ctx, latest, corev1.PodLogOptions{Container: NIMCacheProgressContainerName, TailLines: ptr.To[int64](10)})
+	if err != nil {
+		return nil, err
+	}
+	return modelpuller.ParseProgress(logs)
+}
+
+// updateProgress merges the measured progress into the status, keeping the completed profiles and the earliest
+// start time of each profile
+func updateProgress(status *appsv1alpha1.NIMCacheStatus, progress *modelpuller.Progress) {
+	status.TotalBytes = progress.TotalBytes
+	for _, measured := range progress.Profiles {
+		for i := range status.Progress {
+			profile := &status.Progress[i]
+			if profile.Name != measured.Name || profile.State == appsv1alpha1.ProfileProgressComplete {
+				continue
+			}
+			startTime := profile.StartTime
+			*profile = measured
+			if startTime != nil {
+				profile.StartTime = startTime
+			}
+		}
+	}
+}
+
+// getProgressTargets returns the profiles whose caching progress is measured by the sidecar, the files of the
+// sources other than NGC are measured as a single profile
+func (r *NIMCacheReconciler) getProgressTargets(ctx context.Context, nimCache *appsv1alpha1.NIMCache) ([]modelpuller.ProgressTarget, error) {
+	source := nimCache.Spec.Source
+	switch {
+	case source.NGC != nil:
+		return r.getNGCProgressTargets(ctx, nimCache)
+	case source.HuggingFace != nil && nimCache.Status.HuggingFace != nil:
+		return []modelpuller.ProgressTarget{{Name: "huggingface", Paths: nimCache.Status.HuggingFace.Files, TotalBytes: nimCache.Status.HuggingFace.Size}}, nil
+	case source.S3 != nil:
+		return []modelpuller.ProgressTarget{{Name: "s3", Paths: []string{"."}}}, nil
+	case source.OCI != nil && nimCache.Status.OCI != nil:
+		return []modelpuller.ProgressTarget{{Name: "oci", Paths: []string{"."}, TotalBytes: nimCache.Status.OCI.Size}}, nil
+	case source.DataStore != nil:
+		return []modelpuller.ProgressTarget{{Name: "datastore", Paths: []string{"."}}}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// getNGCProgressTargets returns the selected NGC profiles with the paths of their files in the NIM cache listed by
+// the model manifest, all the profiles are measured as a single profile when none is selected
+func (r *NIMCacheReconciler) getNGCProgressTargets(ctx context.Context, nimCache *appsv1alpha1.NIMCache) ([]modelpuller.ProgressTarget, error) {
+	profiles, _ := getSelectedProfiles(nimCache)
+	if len(profiles) == 0 || utils.ContainsElement(profiles, AllProfiles) {
+		profiles = []string{AllProfiles}
+	}
+
+	var nimManifest nimparser.NIMManifestInterface
+	configMap := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Name: getManifestConfigName(nimCache), Namespace: nimCache.GetNamespace()}, configMap)
+	if err != nil && client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	// The profiles are only reported in their state without the model manifest
+	if err == nil {
+		if nimManifest, err = r.extractNIMManifest(ctx, configMap.Name, configMap.Namespace); err != nil {
+			return nil, fmt.Errorf("failed to get model manifest config file: %w", err)
+		}
+	}
+
+	targets := make([]modelpuller.ProgressTarget, 0, len(profiles))
+	for _, profile := range profiles {
+		target := modelpuller.ProgressTarget{Name: profile}
+		if nimManifest != nil {
+			ids := []string{profile}
+			if profile == AllProfiles {
+				ids = nimManifest.GetProfilesList()
+				slices.Sort(ids)
+			}
+			for _, id := range ids {
+				for _, file := range nimManifest.GetProfileFiles(id) {
+					if !utils.ContainsElement(target.Paths, file) {
+						target.Paths = append(target.Paths, file)
+					}
+				}
+			}
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// getModelPullerReport returns the report of the model puller from the termination message of the succeeded job pod
+func (r *NIMCacheReconciler) getModelPullerReport(ctx context.Context, job *batchv1.Job) (*modelpuller.Report, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(job.GetNamespace()), client.MatchingLabels{"job-name": job.GetName()}); err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == NIMCacheContainerName && status.State.Terminated != nil && status.State.Terminated.ExitCode == 0 {
+				return modelpuller.ParseReport(status.State.Terminated.Message)
+			}
+		}
+	}
+	return nil, fmt.Errorf("no succeeded pod found for job %s", job.GetName())
+}
+
+func (r *NIMCacheReconciler) createPod(ctx context.Context, pod *corev1.Pod) error {
+	// Create pod
+	err := r.Create(ctx, pod)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *NIMCacheReconciler) reconcileNIMCache(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (ctrl.Result, error) {
+	logger := r.GetLogger()
+	defer r.refreshMetrics(ctx)
+
+	// Reconcile ServiceAccount
+	err := r.reconcileServiceAccount(ctx, nimCache)
+	if err != nil {
+		logger.Error(err, "reconciliation of serviceaccount failed")
+		return ctrl.Result{}, err
+	}
+
+	// Reconcile Role
+	err = r.reconcileRole(ctx, nimCache)
+	if err != nil {
+		logger.Error(err, "reconciliation of role failed")
+		return ctrl.Result{}, err
+	}
+
+	// Reconcile RoleBinding
+	err = r.reconcileRoleBinding(ctx, nimCache)
+	if err != nil {
+		logger.Error(err, "reconciliation of rolebinding failed")
+		return ctrl.Result{}, err
+	}
+
+	// Reconcile PVC
 	err = r.reconcilePVC(ctx, nimCache)
 	if err != nil {
 		logger.Error(err, "reconciliation of pvc failed", "pvc", getPvcName(nimCache, nimCache.Spec.Storage.PVC))
@@ -857,6 +1521,20 @@ func (r *NIMCacheReconciler) reconcileNIMCache(ctx context.Context, nimCache *ap
 		return ctrl.Result{}, err
 	}
 
+	// Resolve the Hugging Face revision and files to cache
+	err = r.reconcileHuggingFace(ctx, nimCache)
+	if err != nil {
+		logger.Error(err, "reconciliation of hugging face revision failed")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the digest of the OCI artifact to cache
+	err = r.reconcileOCI(ctx, nimCache)
+	if err != nil {
+		logger.Error(err, "reconciliation of oci digest failed")
+		return ctrl.Result{}, err
+	}
+
 	// Reconcile caching Job
 	err = r.reconcileJob(ctx, nimCache)
 	if err != nil {
@@ -864,6 +1542,13 @@ func (r *NIMCacheReconciler) reconcileNIMCache(ctx context.Context, nimCache *ap
 		return ctrl.Result{}, err
 	}
 
+	// Verify the checksums of the cached files
+	err = r.reconcileVerification(ctx, nimCache)
+	if err != nil {
+		logger.Error(err, "reconciliation of cache verification failed", "job", nimCache.GetVerificationJobName())
+		return ctrl.Result{}, err
+	}
+
 	conditions.IfPresentUpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionReconcileFailed, metav1.ConditionFalse, "Reconciled", "")
 
 	err = r.updateNIMCacheStatus(ctx, nimCache)
@@ -871,6 +1556,11 @@ func (r *NIMCacheReconciler) reconcileNIMCache(ctx context.Context, nimCache *ap
 		logger.Error(err, "Failed to update NIMCache status", "NIMCache", nimCache.Name)
 		return ctrl.Result{}, err
 	}
+
+	// Requeue to refresh the caching progress while the job runs
+	if nimCache.IsProgressEnabled() && nimCache.Status.State != appsv1alpha1.NimCacheStatusReady && nimCache.Status.State != appsv1alpha1.NimCacheStatusFailed {
+		return ctrl.Result{RequeueAfter: modelpuller.ProgressInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -974,11 +1664,12 @@ func constructPodSpec(nimCache *appsv1alpha1.NIMCache, platformType k8sutil.Orch
 		},
 	}
 
+	addProxyToPodSpec(&pod.Spec, appsv1alpha1.GetProxy(nimCache.Spec.Proxy))
+
 	return pod
 }
 
-func (r *NIMCacheReconciler) getPodLogs(ctx context.Context, pod *corev1.Pod) (string, error) {
-	podLogOpts := corev1.PodLogOptions{Container: NIMCacheContainerName}
+func (r *NIMCacheReconciler) getPodLogs(ctx context.Context, pod *corev1.Pod, podLogOpts corev1.PodLogOptions) (string, error) {
 	config, err := rest.InClusterConfig()
 	if err != nil {
 		return "", err
@@ -1181,6 +1872,159 @@ func (r *NIMCacheReconciler) constructJob(ctx context.Context, nimCache *appsv1a
 			}
 		}
 
+		// Allocate GPUs with a DRA resource claim for engines built while caching
+		if nimCache.IsDRAEnabled() {
+			job.Spec.Template.Spec.ResourceClaims = shared.ResourceClaimsForTemplate(nimCache.GetResourceClaimTemplateName())
+			shared.AddResourceClaim(&job.Spec.Template.Spec.Containers[0].Resources)
+		}
+	} else if nimCache.Spec.Source.HuggingFace != nil {
+		if nimCache.Status.HuggingFace == nil {
+			return nil, fmt.Errorf("hugging face revision of the model repository is not resolved")
+		}
+		// Download the resolved files of the pinned commit
+		command := []string{"huggingface-cli", "download", nimCache.Status.HuggingFace.RepoID}
+		command = append(command, nimCache.Status.HuggingFace.Files...)
+		command = append(command, "--revision", nimCache.Status.HuggingFace.Revision, "--local-dir", "/model-store")
+		job.Spec.Template.Spec.Containers = []corev1.Container{
+			{
+				Name:    NIMCacheContainerName,
+				Image:   nimCache.Spec.Source.HuggingFace.ModelPuller,
+				Command: command,
+				EnvFrom: nimCache.Spec.Source.EnvFromSecrets(),
+				Env: []corev1.EnvVar{
+					{
+						Name:  "HF_ENDPOINT",
+						Value: nimCache.Spec.Source.HuggingFace.GetEndpoint(),
+					},
+					{
+						Name:  "HF_HOME",
+						Value: "/tmp/huggingface",
+					},
+					{
+						Name:  "HF_HUB_DISABLE_TELEMETRY",
+						Value: "1",
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      "nim-cache-volume",
+						MountPath: "/model-store",
+						SubPath:   nimCache.Spec.Storage.PVC.SubPath,
+					},
+				},
+				Resources: corev1.ResourceRequirements{
+					Limits: map[corev1.ResourceName]apiResource.Quantity{
+						"cpu":    nimCache.Spec.Resources.CPU,
+						"memory": nimCache.Spec.Resources.Memory,
+					},
+					Requests: map[corev1.ResourceName]apiResource.Quantity{
+						"cpu":    nimCache.Spec.Resources.CPU,
+						"memory": nimCache.Spec.Resources.Memory,
+					},
+				},
+				TerminationMessagePath:   "/dev/termination-log",
+				TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: ptr.To[bool](false),
+					Capabilities: &corev1.Capabilities{
+						Drop: []corev1.Capability{"ALL"},
+					},
+					RunAsNonRoot: ptr.To[bool](true),
+					RunAsGroup:   nimCache.GetGroupID(),
+					RunAsUser:    nimCache.GetUserID(),
+				},
+			},
+		}
+		if nimCache.Spec.Source.HuggingFace.PullSecret != "" {
+			job.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
+				{
+					Name: nimCache.Spec.Source.HuggingFace.PullSecret,
+				},
+			}
+		}
+	} else if nimCache.Spec.Source.S3 != nil || nimCache.Spec.Source.OCI != nil {
+		pullerSource, image, pullSecret, err := getModelPullerSource(nimCache)
+		if err != nil {
+			return nil, err
+		}
+		source, err := json.Marshal(pullerSource)
+		if err != nil {
+			return nil, err
+		}
+		// Pull the model with the model puller of the operator image, reporting the result in the termination message
+		job.Spec.Template.Spec.Containers = []corev1.Container{
+			{
+				Name:    NIMCacheContainerName,
+				Image:   image,
+				Command: []string{"/manager"},
+				Args:    []string{"--cache-source=" + string(source), "--cache-path=/model-store"},
+				EnvFrom: nimCache.Spec.Source.EnvFromSecrets(),
+				Env:     []corev1.EnvVar{},
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      "nim-cache-volume",
+						MountPath: "/model-store",
+						SubPath:   nimCache.Spec.Storage.PVC.SubPath,
+					},
+				},
+				Resources: corev1.ResourceRequirements{
+					Limits: map[corev1.ResourceName]apiResource.Quantity{
+						"cpu":    nimCache.Spec.Resources.CPU,
+						"memory": nimCache.Spec.Resources.Memory,
+					},
+					Requests: map[corev1.ResourceName]apiResource.Quantity{
+						"cpu":    nimCache.Spec.Resources.CPU,
+						"memory": nimCache.Spec.Resources.Memory,
+					},
+				},
+				TerminationMessagePath:   modelpuller.TerminationMessagePath,
+				TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: ptr.To[bool](false),
+					Capabilities: &corev1.Capabilities{
+						Drop: []corev1.Capability{"ALL"},
+					},
+					RunAsNonRoot: ptr.To[bool](true),
+					RunAsGroup:   nimCache.GetGroupID(),
+					RunAsUser:    nimCache.GetUserID(),
+				},
+			},
+		}
+		// Trust the custom CA certificates of the endpoint in addition to the system ones
+		if nimCache.Spec.CertConfig != nil {
+			job.Spec.Template.Spec.Containers[0].Env = append(job.Spec.Template.Spec.Containers[0].Env,
+				corev1.EnvVar{Name: "SSL_CERT_DIR", Value: nimCache.Spec.CertConfig.MountPath})
+		}
+		// Mount the docker config secrets to pull the artifact
+		if nimCache.Spec.Source.OCI != nil {
+			for i, secret := range nimCache.Spec.Source.OCI.PullSecrets {
+				volumeName := fmt.Sprintf("registry-auth-%d", i)
+				job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
+					Name: volumeName,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: secret,
+							Items:      []corev1.KeyToPath{{Key: corev1.DockerConfigJsonKey, Path: modelpuller.DockerConfigFile}},
+						},
+					},
+				})
+				job.Spec.Template.Spec.Containers[0].VolumeMounts = append(job.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+					Name:      volumeName,
+					MountPath: filepath.Join(modelpuller.RegistryAuthPath, secret),
+					ReadOnly:  true,
+				})
+			}
+		}
+		if pullSecret != "" {
+			job.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
+				{
+					Name: pullSecret,
+				},
+			}
+		}
+	}
+
+	if nimCache.Spec.Source.NGC != nil || nimCache.Spec.Source.HuggingFace != nil || nimCache.Spec.Source.S3 != nil || nimCache.Spec.Source.OCI != nil {
 		// Merge env with the user provided values
 		job.Spec.Template.Spec.Containers[0].Env = utils.MergeEnvVars(job.Spec.Template.Spec.Containers[0].Env, nimCache.Spec.Env)
 
@@ -1196,9 +2040,233 @@ func (r *NIMCacheReconciler) constructJob(ctx context.Context, nimCache *appsv1a
 			job.Spec.Template.Spec.Containers[0].VolumeMounts = append(job.Spec.Template.Spec.Containers[0].VolumeMounts, volumeMounts...)
 		}
 	}
+
+	// Download through the proxy, trusting its CA bundle
+	addProxyToPodSpec(&job.Spec.Template.Spec, appsv1alpha1.GetProxy(nimCache.Spec.Proxy))
+
+	if nimCache.IsProgressEnabled() {
+		targets, err := r.getProgressTargets(ctx, nimCache)
+		if err != nil {
+			return nil, err
+		}
+		if err := addProgressSidecar(job, nimCache, targets); err != nil {
+			return nil, err
+		}
+	}
+	return job, nil
+}
+
+// addProgressSidecar adds the native sidecar measuring the caching progress of the profiles to the caching job,
+// it runs with the caching container and is stopped once the caching container terminates
+func addProgressSidecar(job *batchv1.Job, nimCache *appsv1alpha1.NIMCache, targets []modelpuller.ProgressTarget) error {
+	image := nimCache.Spec.Progress.Image
+	if image == "" {
+		image = os.Getenv("OPERATOR_IMAGE")
+	}
+	if image == "" {
+		return fmt.Errorf("progress sidecar image is not set for NIMCache %s", nimCache.GetName())
+	}
+	data, err := json.Marshal(targets)
+	if err != nil {
+		return err
+	}
+
+	job.Spec.Template.Spec.InitContainers = append(job.Spec.Template.Spec.InitContainers, corev1.Container{
+		Name:          NIMCacheProgressContainerName,
+		Image:         image,
+		Command:       []string{"/manager"},
+		Args:          []string{"--report-progress=" + string(data), "--cache-path=/model-store"},
+		RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "nim-cache-volume",
+				MountPath: "/model-store",
+				SubPath:   nimCache.Spec.Storage.PVC.SubPath,
+				ReadOnly:  true,
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To[bool](false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+			RunAsNonRoot: ptr.To[bool](true),
+			RunAsGroup:   nimCache.GetGroupID(),
+			RunAsUser:    nimCache.GetUserID(),
+		},
+	})
+	if nimCache.Spec.Progress.PullSecret != "" {
+		job.Spec.Template.Spec.ImagePullSecrets = append(job.Spec.Template.Spec.ImagePullSecrets, corev1.LocalObjectReference{
+			Name: nimCache.Spec.Progress.PullSecret,
+		})
+	}
+	return nil
+}
+
+// getModelPullerSource returns the source pulled by the model puller of the operator image, its image and pull secret
+func getModelPullerSource(nimCache *appsv1alpha1.NIMCache) (source appsv1alpha1.NIMSource, image, pullSecret string, err error) {
+	switch {
+	case nimCache.Spec.Source.S3 != nil:
+		source.S3 = nimCache.Spec.Source.S3
+		image, pullSecret = nimCache.Spec.Source.S3.ModelPuller, nimCache.Spec.Source.S3.PullSecret
+	case nimCache.Spec.Source.OCI != nil:
+		if nimCache.Status.OCI == nil {
+			return source, "", "", fmt.Errorf("digest of the oci artifact is not resolved")
+		}
+		// Pull the artifact pinned to the resolved digest, with the mounted pull secrets
+		reference, err := modelpuller.PinOCIReference(nimCache.Status.OCI.Reference, nimCache.Status.OCI.Digest)
+		if err != nil {
+			return source, "", "", err
+		}
+		source.OCI = &appsv1alpha1.OCISource{Reference: reference, PlainHTTP: nimCache.Spec.Source.OCI.PlainHTTP}
+		image, pullSecret = nimCache.Spec.Source.OCI.ModelPuller, nimCache.Spec.Source.OCI.PullSecret
+	}
+	if image == "" {
+		image = os.Getenv("OPERATOR_IMAGE")
+	}
+	if image == "" {
+		return source, "", "", fmt.Errorf("model puller image is not set for NIMCache %s", nimCache.GetName())
+	}
+	return source, image, pullSecret, nil
+}
+
+// constructVerificationJob returns the job verifying the cached files against the checksums ConfigMap
+// with the verifier of the operator image
+func constructVerificationJob(nimCache *appsv1alpha1.NIMCache, platformType k8sutil.OrchestratorType) (*batchv1.Job, error) {
+	image := nimCache.Spec.Verification.Image
+	if image == "" {
+		image = os.Getenv("OPERATOR_IMAGE")
+	}
+	if image == "" {
+		return nil, fmt.Errorf("verification image is not set for NIMCache %s", nimCache.GetName())
+	}
+	// NGC caches are verified against the names of their blobs unless the checksums ConfigMap is set
+	args := []string{"--verify-checksums=" + modelpuller.ChecksumsPath, "--cache-path=/model-store"}
+	verifyBlobs := nimCache.Spec.Source.NGC != nil && nimCache.Spec.Verification.Checksums == ""
+	if verifyBlobs {
+		args = append(args, "--verify-blobs")
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nimCache.GetVerificationJobName(),
+			Namespace: nimCache.GetNamespace(),
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app":                          "k8s-nim-operator",
+						"app.kubernetes.io/name":       nimCache.GetName(),
+						"app.kubernetes.io/managed-by": "k8s-nim-operator",
+					},
+					Annotations: map[string]string{
+						"sidecar.istio.io/inject": "false",
+					},
+				},
+				Spec: corev1.PodSpec{
+					RuntimeClassName: nimCache.GetRuntimeClassName(),
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsUser:    nimCache.GetUserID(),
+						FSGroup:      nimCache.GetGroupID(),
+						RunAsNonRoot: ptr.To[bool](true),
+					},
+					Containers: []corev1.Container{
+						{
+							Name:    NIMCacheContainerName,
+							Image:   image,
+							Command: []string{"/manager"},
+							Args:    args,
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "nim-cache-volume",
+									MountPath: "/model-store",
+									SubPath:   nimCache.Spec.Storage.PVC.SubPath,
+									ReadOnly:  true,
+								},
+								{
+									Name:      "checksums",
+									MountPath: modelpuller.ChecksumsPath,
+									ReadOnly:  true,
+								},
+							},
+							TerminationMessagePath:   modelpuller.TerminationMessagePath,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To[bool](false),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+								RunAsNonRoot: ptr.To[bool](true),
+								RunAsGroup:   nimCache.GetGroupID(),
+								RunAsUser:    nimCache.GetUserID(),
+							},
+						},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+					Volumes: []corev1.Volume{
+						{
+							Name: "nim-cache-volume",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: getPvcName(nimCache, nimCache.Spec.Storage.PVC),
+								},
+							},
+						},
+						{
+							Name: "checksums",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: nimCache.GetChecksumsConfigMapName(),
+									},
+									Optional: ptr.To[bool](verifyBlobs),
+								},
+							},
+						},
+					},
+					ServiceAccountName: NIMCacheServiceAccount,
+					Tolerations:        nimCache.GetTolerations(),
+					NodeSelector:       nimCache.GetNodeSelectors(),
+				},
+			},
+			BackoffLimit:            ptr.To[int32](2),
+			TTLSecondsAfterFinished: ptr.To[int32](600),
+		},
+	}
+
+	// SeccompProfile must be set for TKGS
+	if platformType == k8sutil.TKGS {
+		job.Spec.Template.Spec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		}
+	}
+	if platformType == k8sutil.OpenShift {
+		job.Spec.Template.Annotations["openshift.io/scc"] = "nonroot"
+	}
+	if nimCache.Spec.Verification.PullSecret != "" {
+		job.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
+			{
+				Name: nimCache.Spec.Verification.PullSecret,
+			},
+		}
+	}
 	return job, nil
 }
 
+// addProxyToPodSpec sets the proxy env variables and mounts the CA bundle in the containers of the pod
+func addProxyToPodSpec(podSpec *corev1.PodSpec, proxy *appsv1alpha1.ProxySpec) {
+	if proxy == nil {
+		return
+	}
+	podSpec.Volumes = append(podSpec.Volumes, proxy.GetVolumes()...)
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		container.Env = utils.MergeEnvVars(proxy.GetEnv(), container.Env)
+		container.VolumeMounts = append(container.VolumeMounts, proxy.GetVolumeMounts()...)
+	}
+}
+
 // getConfigMap retrieves the given ConfigMap
 func (r *NIMCacheReconciler) getConfigMap(ctx context.Context, name, namespace string) (*corev1.ConfigMap, error) {
 	configMap := &corev1.ConfigMap{}
@@ -1206,6 +2274,23 @@ func (r *NIMCacheReconciler) getConfigMap(ctx context.Context, name, namespace s
 	return configMap, err
 }
 
+// getCertPool returns the system CAs with the custom certificates of the NIMCache, to reach the model endpoints from
+// the operator, nil when none are configured
+func (r *NIMCacheReconciler) getCertPool(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (*x509.CertPool, error) {
+	if nimCache.Spec.CertConfig == nil {
+		return nil, nil
+	}
+	certConfig, err := r.getConfigMap(ctx, nimCache.Spec.CertConfig.Name, nimCache.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom certificates configmap %s: %w", nimCache.Spec.CertConfig.Name, err)
+	}
+	rootCAs, err := utils.NewCertPool(slices.Collect(maps.Values(certConfig.Data))...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid custom certificates configmap %s: %w", nimCache.Spec.CertConfig.Name, err)
+	}
+	return rootCAs, nil
+}
+
 func (r *NIMCacheReconciler) createCertVolumesAndMounts(ctx context.Context, nimCache *appsv1alpha1.NIMCache) ([]corev1.Volume, []corev1.VolumeMount, error) {
 	logger := log.FromContext(ctx)
 
@@ -1323,26 +2408,11 @@ func (r *NIMCacheReconciler) createManifestConfigMap(ctx context.Context, nimCac
 // GetNodeGPUProducts retrieves the value of the "nvidia.com/gpu.product" label from all nodes in the cluster,
 // filtering nodes where this label is not empty.
 func (r *NIMCacheReconciler) GetNodeGPUProducts(ctx context.Context) (map[string]string, error) {
-	logger := r.GetLogger()
-
-	// List all nodes
-	nodeList := &corev1.NodeList{}
-	err := r.Client.List(ctx, nodeList)
+	nodeGPUProducts, err := k8sutil.GetNodeGPUProducts(ctx, r.Client)
 	if err != nil {
-		logger.Error(err, "unable to list nodes to detect gpu types in the cluster")
+		r.GetLogger().Error(err, "unable to list nodes to detect gpu types in the cluster")
 		return nil, fmt.Errorf("unable to list gpu nodes: %w", err)
 	}
-
-	// Map to store node names and their GPU product labels
-	nodeGPUProducts := make(map[string]string)
-
-	// Iterate over the nodes and filter by the GPU product label
-	for _, node := range nodeList.Items {
-		if gpuProduct, ok := node.Labels["nvidia.com/gpu.product"]; ok && strings.TrimSpace(gpuProduct) != "" {
-			nodeGPUProducts[node.Name] = gpuProduct
-		}
-	}
-
 	return nodeGPUProducts, nil
 }
 
@@ -18,8 +18,16 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -27,7 +35,9 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -39,8 +49,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/standalone"
 	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/modelpuller"
 	nimparserv1 "github.com/NVIDIA/k8s-nim-operator/internal/nimparser/v1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
 )
 
 var _ = Describe("NIMCache Controller", func() {
@@ -55,6 +68,7 @@ var _ = Describe("NIMCache Controller", func() {
 		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
 		Expect(corev1.AddToScheme(scheme)).To(Succeed())
 		Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+		Expect(resourcev1alpha3.AddToScheme(scheme)).To(Succeed())
 
 		cli = fake.NewClientBuilder().WithScheme(scheme).
 			WithStatusSubresource(&appsv1alpha1.NIMCache{}).
@@ -65,6 +79,7 @@ var _ = Describe("NIMCache Controller", func() {
 			Client:   cli,
 			scheme:   scheme,
 			recorder: record.NewFakeRecorder(1000),
+			Platform: &standalone.Standalone{},
 		}
 
 		nimCache := &appsv1alpha1.NIMCache{
@@ -485,6 +500,37 @@ var _ = Describe("NIMCache Controller", func() {
 			Expect(job.Spec.Template.Spec.Containers[0].Args).To(ContainElements("download-to-cache", "--all"))
 		})
 
+		It("should construct a job claiming GPUs with DRA", func() {
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "nvcr.io/nim:test", PullSecret: "my-secret", Model: appsv1alpha1.ModelSpec{
+						Profiles:          []string{AllProfiles},
+						TensorParallelism: "2",
+						GPUs:              []appsv1alpha1.GPUSpec{{Product: "A100"}},
+					}}},
+					DRA: &appsv1alpha1.DRAResources{},
+				},
+			}
+			Expect(cli.Create(context.TODO(), nimCache)).To(Succeed())
+
+			Expect(reconciler.reconcileResourceClaimTemplate(context.TODO(), nimCache)).To(Succeed())
+			template := &resourcev1alpha3.ResourceClaimTemplate{}
+			Expect(cli.Get(context.TODO(), types.NamespacedName{Name: "test-nimcache-gpu", Namespace: "default"}, template)).To(Succeed())
+			Expect(template.Spec.Spec.Devices.Requests[0].Count).To(Equal(int64(2)))
+			Expect(template.Spec.Spec.Devices.Requests[0].Selectors[0].CEL.Expression).To(Equal(
+				`device.attributes["gpu.nvidia.com"].productName.matches("(?i)(^|[^[:alnum:]])A100([^[:alnum:]]|$)")`))
+
+			job, err := reconciler.constructJob(context.TODO(), nimCache, k8sutil.K8s)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job.Spec.Template.Spec.ResourceClaims).To(HaveLen(1))
+			Expect(*job.Spec.Template.Spec.ResourceClaims[0].ResourceClaimTemplateName).To(Equal("test-nimcache-gpu"))
+			Expect(job.Spec.Template.Spec.Containers[0].Resources.Claims).To(ContainElement(corev1.ResourceClaim{Name: "gpu"}))
+		})
+
 		It("should create a job with the correct specifications", func() {
 			profiles := []string{"36fc1fa4fc35c1d54da115a39323080b08d7937dceb8ba47be44f4da0ec720ff"}
 			profilesJSON, err := json.Marshal(profiles)
@@ -616,6 +662,742 @@ var _ = Describe("NIMCache Controller", func() {
 			))
 		})
 
+		It("should apply the operator and NIMCache proxy configuration to the job and the manifest pod", func() {
+			for _, env := range []string{"HTTPS_PROXY", "NO_PROXY", appsv1alpha1.ProxyCAConfigMapEnv} {
+				DeferCleanup(os.Setenv, env, os.Getenv(env))
+			}
+			Expect(os.Setenv("HTTPS_PROXY", "http://operator-proxy:3128")).To(Succeed())
+			Expect(os.Setenv("NO_PROXY", ".svc,.cluster.local")).To(Succeed())
+			Expect(os.Setenv(appsv1alpha1.ProxyCAConfigMapEnv, "operator-ca-bundle")).To(Succeed())
+
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "nvcr.io/nim:test", PullSecret: "my-secret", Model: appsv1alpha1.ModelSpec{Profiles: []string{AllProfiles}}}},
+					Proxy:  &appsv1alpha1.ProxySpec{HTTPSProxy: "http://nimcache-proxy:3128"},
+				},
+			}
+
+			job, err := reconciler.constructJob(context.TODO(), nimCache, k8sutil.K8s)
+			Expect(err).ToNot(HaveOccurred())
+			pod := constructPodSpec(nimCache, k8sutil.K8s)
+			for _, podSpec := range []corev1.PodSpec{job.Spec.Template.Spec, pod.Spec} {
+				// The NIMCache proxy takes precedence over the operator defaults
+				Expect(podSpec.Containers[0].Env).To(ContainElements(
+					corev1.EnvVar{Name: "HTTPS_PROXY", Value: "http://nimcache-proxy:3128"},
+					corev1.EnvVar{Name: "https_proxy", Value: "http://nimcache-proxy:3128"},
+					corev1.EnvVar{Name: "NO_PROXY", Value: ".svc,.cluster.local"},
+					corev1.EnvVar{Name: "SSL_CERT_FILE", Value: "/etc/nim/proxy-ca/ca-bundle.crt"},
+				))
+				Expect(podSpec.Volumes).To(ContainElement(HaveField("ConfigMap.LocalObjectReference.Name", "operator-ca-bundle")))
+				Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "proxy-ca-bundle", MountPath: "/etc/nim/proxy-ca", ReadOnly: true}))
+			}
+		})
+
+		It("should apply the NIMOperatorConfig defaults to the job", func() {
+			ctx := context.TODO()
+
+			// No defaults are applied without a NIMOperatorConfig
+			operatorConfig, err := shared.GetOperatorConfig(ctx, cli)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operatorConfig).To(BeNil())
+
+			Expect(cli.Create(ctx, &appsv1alpha1.NIMOperatorConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: appsv1alpha1.NIMOperatorConfigName},
+				Spec: appsv1alpha1.NIMOperatorConfigSpec{
+					RegistryMirrors: []appsv1alpha1.RegistryMirror{{Source: "nvcr.io", Mirror: "registry.example.com/nvcr"}},
+					PullSecrets:     []string{"mirror-secret"},
+					StorageClass:    "fast",
+				},
+			})).To(Succeed())
+
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source:  appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "nvcr.io/nim:test", Model: appsv1alpha1.ModelSpec{Profiles: []string{AllProfiles}}}},
+					Storage: appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), Size: "1Gi"}},
+				},
+			}
+			operatorConfig, err = shared.GetOperatorConfig(ctx, cli)
+			Expect(err).ToNot(HaveOccurred())
+			operatorConfig.ApplyToNIMCache(nimCache)
+			Expect(nimCache.Spec.Storage.PVC.StorageClass).To(Equal("fast"))
+
+			job, err := reconciler.constructJob(ctx, nimCache, k8sutil.K8s)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal("registry.example.com/nvcr/nim:test"))
+			Expect(job.Spec.Template.Spec.ImagePullSecrets).To(ContainElement(corev1.LocalObjectReference{Name: "mirror-secret"}))
+		})
+
+		It("should resolve the hugging face revision and pin the caching job to it", func() {
+			ctx := context.TODO()
+			requests := 0
+			hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.URL.Path != "/api/models/meta-llama/Llama-3.1-8B-Instruct/revision/v1.0" || r.Header.Get("Authorization") != "Bearer hf_test" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(`{"sha":"0e9e39f249a16976918f6564b8830bc894c89659","siblings":[` +
+					`{"rfilename":"config.json"},{"rfilename":"model.safetensors"},{"rfilename":"original/consolidated.00.pth"}]}`))
+			}))
+			defer hub.Close()
+
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "hf-token", Namespace: "default"},
+				Data:       map[string][]byte{"HF_TOKEN": []byte("hf_test")},
+			})).To(Succeed())
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{HuggingFace: &appsv1alpha1.HuggingFaceSource{
+						RepoID:      "meta-llama/Llama-3.1-8B-Instruct",
+						Revision:    "v1.0",
+						Exclude:     []string{"original/*"},
+						AuthSecret:  "hf-token",
+						Endpoint:    hub.URL,
+						ModelPuller: "nvcr.io/nim/huggingface-cli:latest",
+					}},
+					Storage: appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+				},
+			}
+			Expect(cli.Create(ctx, nimCache)).To(Succeed())
+
+			_, err := reconciler.reconcileNIMCache(ctx, nimCache)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := &appsv1alpha1.NIMCache{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache", Namespace: "default"}, updated)).To(Succeed())
+			Expect(updated.Status.HuggingFace.RepoID).To(Equal("meta-llama/Llama-3.1-8B-Instruct"))
+			Expect(updated.Status.HuggingFace.Revision).To(Equal("0e9e39f249a16976918f6564b8830bc894c89659"))
+			Expect(updated.Status.HuggingFace.Files).To(Equal([]string{"config.json", "model.safetensors"}))
+			Expect(updated.Status.HuggingFace.SourceHash).ToNot(BeEmpty())
+
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-job", Namespace: "default"}, job)).To(Succeed())
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("nvcr.io/nim/huggingface-cli:latest"))
+			Expect(container.Command).To(Equal([]string{"huggingface-cli", "download", "meta-llama/Llama-3.1-8B-Instruct", "config.json", "model.safetensors",
+				"--revision", "0e9e39f249a16976918f6564b8830bc894c89659", "--local-dir", "/model-store"}))
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "HF_ENDPOINT", Value: hub.URL}))
+			Expect(container.EnvFrom).To(ContainElement(HaveField("SecretRef.LocalObjectReference.Name", "hf-token")))
+
+			// The job completes with the pinned revision
+			job.Status.Succeeded = 1
+			Expect(reconciler.reconcileJobStatus(ctx, updated, job)).To(Succeed())
+			Expect(updated.Status.State).To(Equal(appsv1alpha1.NimCacheStatusReady))
+
+			// The revision is resolved again only when the source changes
+			resolved := requests
+			Expect(reconciler.reconcileHuggingFace(ctx, updated)).To(Succeed())
+			Expect(requests).To(Equal(resolved))
+			sourceHash := updated.Status.HuggingFace.SourceHash
+			updated.Spec.Source.HuggingFace.Exclude = nil
+			Expect(reconciler.reconcileHuggingFace(ctx, updated)).To(Succeed())
+			Expect(requests).To(Equal(resolved + 1))
+			Expect(updated.Status.HuggingFace.Files).To(Equal([]string{"config.json", "model.safetensors", "original/consolidated.00.pth"}))
+			Expect(updated.Status.HuggingFace.SourceHash).ToNot(Equal(sourceHash))
+
+			// The changed files are cached and verified again
+			Expect(updated.Status.State).To(Equal(appsv1alpha1.NimCacheStatusNotReady))
+			Expect(meta.FindStatusCondition(updated.Status.Conditions, appsv1alpha1.NimCacheConditionJobCompleted)).To(BeNil())
+			err = cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-job", Namespace: "default"}, job)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(reconciler.reconcileJob(ctx, updated)).To(Succeed())
+			Expect(updated.Status.State).To(Equal(appsv1alpha1.NimCacheStatusStarted))
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-job", Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Spec.Template.Spec.Containers[0].Command).To(ContainElement("original/consolidated.00.pth"))
+		})
+
+		It("should resolve the hugging face revision trusting the custom certificates", func() {
+			ctx := context.TODO()
+			hub := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"sha":"0e9e39f249a16976918f6564b8830bc894c89659","siblings":[{"rfilename":"config.json"}]}`))
+			}))
+			defer hub.Close()
+
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{HuggingFace: &appsv1alpha1.HuggingFaceSource{
+						RepoID:      "meta-llama/Llama-3.1-8B-Instruct",
+						Endpoint:    hub.URL,
+						ModelPuller: "nvcr.io/nim/huggingface-cli:latest",
+					}},
+					CertConfig: &appsv1alpha1.CertConfig{Name: "hub-ca", MountPath: "/etc/ssl/hub"},
+				},
+			}
+			Expect(reconciler.reconcileHuggingFace(ctx, nimCache)).To(MatchError(ContainSubstring("hub-ca")))
+
+			Expect(cli.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "hub-ca", Namespace: "default"},
+				Data: map[string]string{
+					"ca.crt": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: hub.Certificate().Raw})),
+				},
+			})).To(Succeed())
+			Expect(reconciler.reconcileHuggingFace(ctx, nimCache)).To(Succeed())
+			Expect(nimCache.Status.HuggingFace.Revision).To(Equal("0e9e39f249a16976918f6564b8830bc894c89659"))
+		})
+
+		It("should verify the cached hugging face files against the checksums of the hub", func() {
+			ctx := context.TODO()
+			DeferCleanup(os.Setenv, "OPERATOR_IMAGE", os.Getenv("OPERATOR_IMAGE"))
+			Expect(os.Setenv("OPERATOR_IMAGE", "nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0")).To(Succeed())
+
+			hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/models/meta-llama/Llama-3.1-8B-Instruct/revision/main" &&
+					r.URL.Path != "/api/models/meta-llama/Llama-3.1-8B-Instruct/revision/0e9e39f249a16976918f6564b8830bc894c89659" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(`{"sha":"0e9e39f249a16976918f6564b8830bc894c89659","siblings":[` +
+					`{"rfilename":"config.json","blobId":"0bb7c5a4a3a4cbba3ffa5b4a9c5e6a2a5b1f1f8b"},` +
+					`{"rfilename":"model.safetensors","blobId":"2a3e4c8e0c4e1f9b5d8a5e1c6b2f7d3a9e4b5c6d",` +
+					`"lfs":{"sha256":"68fb2bb1e1e1a8c6e6b4d5f0e5f6b1d0c9e3d8c3a6e4b2f1d0c9e8f7a6b5c4d3","size":16060522656}}]}`))
+			}))
+			defer hub.Close()
+
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{HuggingFace: &appsv1alpha1.HuggingFaceSource{
+						RepoID:      "meta-llama/Llama-3.1-8B-Instruct",
+						Endpoint:    hub.URL,
+						ModelPuller: "nvcr.io/nim/huggingface-cli:latest",
+					}},
+					Storage:      appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+					Verification: &appsv1alpha1.VerificationSpec{},
+				},
+			}
+			Expect(cli.Create(ctx, nimCache)).To(Succeed())
+			_, err := reconciler.reconcileNIMCache(ctx, nimCache)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nimCache.IsVerified()).To(BeFalse())
+
+			// The verification job starts once the caching job completes
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-job", Namespace: "default"}, job)).To(Succeed())
+			job.Status.Succeeded = 1
+			Expect(cli.Status().Update(ctx, job)).To(Succeed())
+			_, err = reconciler.reconcileNIMCache(ctx, nimCache)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nimCache.Status.State).To(Equal(appsv1alpha1.NimCacheStatusReady))
+			Expect(meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified)).To(HaveField("Status", metav1.ConditionUnknown))
+
+			checksums := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-checksums", Namespace: "default"}, checksums)).To(Succeed())
+			Expect(checksums.Data).To(Equal(map[string]string{
+				"huggingface": "gitsha1:0bb7c5a4a3a4cbba3ffa5b4a9c5e6a2a5b1f1f8b  config.json\n" +
+					"sha256:68fb2bb1e1e1a8c6e6b4d5f0e5f6b1d0c9e3d8c3a6e4b2f1d0c9e8f7a6b5c4d3  model.safetensors\n",
+			}))
+
+			verifyJob := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-verify-job", Namespace: "default"}, verifyJob)).To(Succeed())
+			container := verifyJob.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0"))
+			Expect(container.Args).To(Equal([]string{"--verify-checksums=/etc/nim/checksums", "--cache-path=/model-store"}))
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "nim-cache-volume", MountPath: "/model-store", ReadOnly: true}))
+			Expect(verifyJob.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("ConfigMap.LocalObjectReference.Name", "test-nimcache-checksums")))
+
+			// The verification result is reported in the termination message of the succeeded pod
+			Expect(cli.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-nimcache-verify-job-abcde", Namespace: "default", Labels: map[string]string{"job-name": "test-nimcache-verify-job"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: NIMCacheContainerName, Image: container.Image}}},
+				Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  NIMCacheContainerName,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: `{"verification":[{"profile":"huggingface","files":2,"verifiedFiles":2}]}`}},
+				}}},
+			})).To(Succeed())
+			verifyJob.Status.Succeeded = 1
+			Expect(cli.Status().Update(ctx, verifyJob)).To(Succeed())
+			_, err = reconciler.reconcileNIMCache(ctx, nimCache)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := &appsv1alpha1.NIMCache{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache", Namespace: "default"}, updated)).To(Succeed())
+			Expect(updated.IsVerified()).To(BeTrue())
+			Expect(updated.Status.Verification).To(Equal([]appsv1alpha1.ProfileVerification{{Profile: "huggingface", Files: 2, VerifiedFiles: 2}}))
+		})
+
+		It("should not verify a cache without checksums", func() {
+			ctx := context.TODO()
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source:       appsv1alpha1.NIMSource{DataStore: &appsv1alpha1.DataStoreSource{Endpoint: "http://datastore:8000"}},
+					Verification: &appsv1alpha1.VerificationSpec{},
+				},
+				Status: appsv1alpha1.NIMCacheStatus{State: appsv1alpha1.NimCacheStatusReady},
+			}
+			Expect(reconciler.reconcileVerification(ctx, nimCache)).To(Succeed())
+			condition := meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NoChecksums"))
+
+			// The checksums of the user are required to exist
+			nimCache.Status.Conditions = nil
+			nimCache.Spec.Verification.Checksums = "llama-checksums"
+			Expect(reconciler.reconcileVerification(ctx, nimCache)).To(MatchError(ContainSubstring("failed to get checksums ConfigMap llama-checksums")))
+		})
+
+		It("should verify the ngc caches against their blobs", func() {
+			ctx := context.TODO()
+			DeferCleanup(os.Setenv, "OPERATOR_IMAGE", os.Getenv("OPERATOR_IMAGE"))
+			Expect(os.Setenv("OPERATOR_IMAGE", "nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0")).To(Succeed())
+
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source:       appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "nvcr.io/nim/meta/llama3-8b-instruct:1.0.0"}},
+					Storage:      appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+					Verification: &appsv1alpha1.VerificationSpec{},
+				},
+				Status: appsv1alpha1.NIMCacheStatus{State: appsv1alpha1.NimCacheStatusReady},
+			}
+			Expect(reconciler.reconcileVerification(ctx, nimCache)).To(Succeed())
+			Expect(meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified)).To(HaveField("Status", metav1.ConditionUnknown))
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-checksums", Namespace: "default"}, &corev1.ConfigMap{})).To(Satisfy(errors.IsNotFound))
+
+			verifyJob := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-verify-job", Namespace: "default"}, verifyJob)).To(Succeed())
+			container := verifyJob.Spec.Template.Spec.Containers[0]
+			Expect(container.Args).To(Equal([]string{"--verify-checksums=/etc/nim/checksums", "--cache-path=/model-store", "--verify-blobs"}))
+			Expect(verifyJob.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("ConfigMap.Optional", ptr.To[bool](true))))
+
+			// A cache without blobs has nothing to verify
+			Expect(cli.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-nimcache-verify-job-abcde", Namespace: "default", Labels: map[string]string{"job-name": "test-nimcache-verify-job"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: NIMCacheContainerName, Image: container.Image}}},
+				Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  NIMCacheContainerName,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: `{}`}},
+				}}},
+			})).To(Succeed())
+			verifyJob.Status.Succeeded = 1
+			Expect(cli.Status().Update(ctx, verifyJob)).To(Succeed())
+			Expect(reconciler.reconcileVerification(ctx, nimCache)).To(Succeed())
+			condition := meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NoChecksums"))
+		})
+
+		It("should verify the cached s3 objects against their checksums", func() {
+			ctx := context.TODO()
+			DeferCleanup(os.Setenv, "OPERATOR_IMAGE", os.Getenv("OPERATOR_IMAGE"))
+			Expect(os.Setenv("OPERATOR_IMAGE", "nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0")).To(Succeed())
+
+			checksum := sha256.Sum256([]byte("model"))
+			s3 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/models" && r.URL.Query().Get("prefix") == "llama/":
+					_, _ = w.Write([]byte(`<ListBucketResult>` +
+						`<Contents><Key>llama/</Key><ETag>"d41d8cd98f00b204e9800998ecf8427e"</ETag><Size>0</Size></Contents>` +
+						`<Contents><Key>llama/config.json</Key><ETag>"0BB7C5A4A3A4CBBA3FFA5B4A9C5E6A2A"</ETag><Size>855</Size></Contents>` +
+						`<Contents><Key>llama/model.safetensors</Key><ETag>"6d8b2f1a-2"</ETag><Size>5</Size></Contents>` +
+						`<Contents><Key>llama/tokenizer.json</Key><ETag>"3f2c1d9e-3"</ETag><Size>9</Size></Contents>` +
+						`</ListBucketResult>`))
+				case r.Method == http.MethodHead && r.URL.Path == "/models/llama/model.safetensors":
+					w.Header().Set("X-Amz-Checksum-Sha256", base64.StdEncoding.EncodeToString(checksum[:]))
+				case r.Method == http.MethodHead:
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer s3.Close()
+
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "minio-credentials", Namespace: "default"},
+				Data:       map[string][]byte{"AWS_ACCESS_KEY_ID": []byte("minio"), "AWS_SECRET_ACCESS_KEY": []byte("minio123")},
+			})).To(Succeed())
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{S3: &appsv1alpha1.S3Source{
+						Endpoint:          s3.URL,
+						Bucket:            "models",
+						Prefix:            "llama/",
+						CredentialsSecret: "minio-credentials",
+					}},
+					Storage:      appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+					Verification: &appsv1alpha1.VerificationSpec{},
+				},
+				Status: appsv1alpha1.NIMCacheStatus{State: appsv1alpha1.NimCacheStatusReady},
+			}
+			Expect(reconciler.reconcileVerification(ctx, nimCache)).To(Succeed())
+			Expect(meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionVerified)).To(HaveField("Status", metav1.ConditionUnknown))
+
+			// The objects uploaded in multiple parts without a full object checksum are not verified
+			checksums := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-checksums", Namespace: "default"}, checksums)).To(Succeed())
+			Expect(checksums.Data).To(Equal(map[string]string{
+				"s3": "md5:0bb7c5a4a3a4cbba3ffa5b4a9c5e6a2a  config.json\n" +
+					fmt.Sprintf("sha256:%x  model.safetensors\n", checksum),
+			}))
+
+			verifyJob := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-verify-job", Namespace: "default"}, verifyJob)).To(Succeed())
+			Expect(verifyJob.Spec.Template.Spec.Containers[0].Args).To(Equal([]string{"--verify-checksums=/etc/nim/checksums", "--cache-path=/model-store"}))
+		})
+
+		It("should measure the ngc profiles in the files of the model manifest", func() {
+			ctx := context.TODO()
+			DeferCleanup(os.Setenv, "OPERATOR_IMAGE", os.Getenv("OPERATOR_IMAGE"))
+			Expect(os.Setenv("OPERATOR_IMAGE", "nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0")).To(Succeed())
+
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache-ngc",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source:   appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "nvcr.io/nim/meta/llama3-8b-instruct:1.0.0", Model: appsv1alpha1.ModelSpec{Profiles: []string{"throughput"}}}},
+					Storage:  appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+					Progress: &appsv1alpha1.ProgressSpec{},
+				},
+			}
+
+			// The profiles are only reported in their state without the model manifest
+			targets, err := reconciler.getProgressTargets(ctx, nimCache)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets).To(Equal([]modelpuller.ProgressTarget{{Name: "throughput"}}))
+
+			manifest, err := nimparserv1.NIMParser{}.ParseModelManifestFromRawOutput([]byte(`
+throughput:
+  model: meta/llama3-8b-instruct
+  workspace:
+    components:
+    - dst: ''
+      src:
+        files:
+        - config.json
+        - tokenizer.json
+        repo_id: ngc://nim/meta/llama3-8b-instruct:hf
+    - dst: trtllm_engine
+      src:
+        files:
+        - rank0.engine
+        repo_id: ngc://nim/meta/llama3-8b-instruct:0.10.0+1.0.0rc5-h100x1-fp8-throughput
+latency:
+  model: meta/llama3-8b-instruct
+  workspace:
+    components:
+    - dst: ''
+      src:
+        files:
+        - config.json
+        - tokenizer.json
+        repo_id: ngc://nim/meta/llama3-8b-instruct:hf
+    - dst: ''
+      src:
+        files:
+        - LICENSE
+        repo_id: hf://meta-llama/Meta-Llama-3-8B-Instruct
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reconciler.createManifestConfigMap(ctx, nimCache, &manifest)).To(Succeed())
+
+			job, err := reconciler.constructJob(ctx, nimCache, k8sutil.K8s)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job.Spec.Template.Spec.InitContainers).To(ContainElement(HaveField("Args", ContainElement(
+				`--report-progress=[{"name":"throughput","paths":[`+
+					`"ngc/hub/models--nim--meta--llama3-8b-instruct/snapshots/hf/config.json",`+
+					`"ngc/hub/models--nim--meta--llama3-8b-instruct/snapshots/hf/tokenizer.json",`+
+					`"ngc/hub/models--nim--meta--llama3-8b-instruct/snapshots/0.10.0+1.0.0rc5-h100x1-fp8-throughput/rank0.engine"]}]`))))
+
+			// All the profiles are measured as a single profile, the files of other repositories are not measured
+			nimCache.Spec.Source.NGC.Model.Profiles = []string{AllProfiles}
+			targets, err = reconciler.getProgressTargets(ctx, nimCache)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets).To(Equal([]modelpuller.ProgressTarget{{Name: AllProfiles, Paths: []string{
+				"ngc/hub/models--nim--meta--llama3-8b-instruct/snapshots/hf/config.json",
+				"ngc/hub/models--nim--meta--llama3-8b-instruct/snapshots/hf/tokenizer.json",
+				"ngc/hub/models--nim--meta--llama3-8b-instruct/snapshots/0.10.0+1.0.0rc5-h100x1-fp8-throughput/rank0.engine",
+			}}}))
+		})
+
+		It("should report the caching progress of the profiles measured by the sidecar", func() {
+			ctx := context.TODO()
+			DeferCleanup(os.Setenv, "OPERATOR_IMAGE", os.Getenv("OPERATOR_IMAGE"))
+			Expect(os.Setenv("OPERATOR_IMAGE", "nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0")).To(Succeed())
+
+			hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"sha":"0e9e39f249a16976918f6564b8830bc894c89659","siblings":[` +
+					`{"rfilename":"config.json","size":855},` +
+					`{"rfilename":"model.safetensors","size":135,"lfs":{"sha256":"68fb2bb1","size":16060522656}}]}`))
+			}))
+			defer hub.Close()
+
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{HuggingFace: &appsv1alpha1.HuggingFaceSource{
+						RepoID:      "meta-llama/Llama-3.1-8B-Instruct",
+						Endpoint:    hub.URL,
+						ModelPuller: "nvcr.io/nim/huggingface-cli:latest",
+					}},
+					Storage:  appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+					Progress: &appsv1alpha1.ProgressSpec{PullSecret: "operator-secret"},
+				},
+			}
+			Expect(cli.Create(ctx, nimCache)).To(Succeed())
+			result, err := reconciler.reconcileNIMCache(ctx, nimCache)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(modelpuller.ProgressInterval))
+			Expect(nimCache.Status.Progress).To(Equal([]appsv1alpha1.ProfileProgress{
+				{Name: "huggingface", State: appsv1alpha1.ProfileProgressPending, TotalBytes: 16060523511},
+			}))
+
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-job", Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+			sidecar := job.Spec.Template.Spec.InitContainers[0]
+			Expect(sidecar.Name).To(Equal(NIMCacheProgressContainerName))
+			Expect(sidecar.Image).To(Equal("nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0"))
+			Expect(sidecar.RestartPolicy).To(Equal(ptr.To(corev1.ContainerRestartPolicyAlways)))
+			Expect(sidecar.Args).To(Equal([]string{
+				`--report-progress=[{"name":"huggingface","paths":["config.json","model.safetensors"],"totalBytes":16060523511}]`,
+				"--cache-path=/model-store",
+			}))
+			Expect(sidecar.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "nim-cache-volume", MountPath: "/model-store", ReadOnly: true}))
+			Expect(job.Spec.Template.Spec.ImagePullSecrets).To(ContainElement(corev1.LocalObjectReference{Name: "operator-secret"}))
+
+			// The measured progress keeps the start time of the profile
+			startTime := metav1.NewTime(time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC))
+			nimCache.Status.Progress[0].StartTime = &startTime
+			updateProgress(&nimCache.Status, &modelpuller.Progress{
+				Profiles: []appsv1alpha1.ProfileProgress{{
+					Name: "huggingface", State: appsv1alpha1.ProfileProgressDownloading, BytesDownloaded: 855, TotalBytes: 16060523511,
+					StartTime: &metav1.Time{Time: startTime.Add(time.Minute)},
+				}},
+				TotalBytes: 8030261760,
+			})
+			Expect(nimCache.Status.TotalBytes).To(Equal(int64(8030261760)))
+			Expect(nimCache.Status.Progress).To(Equal([]appsv1alpha1.ProfileProgress{{
+				Name: "huggingface", State: appsv1alpha1.ProfileProgressDownloading, BytesDownloaded: 855, TotalBytes: 16060523511, StartTime: &startTime,
+			}}))
+
+			// The profiles complete with the job
+			completionTime := metav1.NewTime(startTime.Add(time.Hour))
+			job.Status.Succeeded = 1
+			job.Status.CompletionTime = &completionTime
+			Expect(reconciler.reconcileJobStatus(ctx, nimCache, job)).To(Succeed())
+			Expect(nimCache.Status.Progress).To(Equal([]appsv1alpha1.ProfileProgress{{
+				Name: "huggingface", State: appsv1alpha1.ProfileProgressComplete, BytesDownloaded: 855, TotalBytes: 16060523511,
+				StartTime: &startTime, FinishTime: &completionTime,
+			}}))
+		})
+
+		It("should fail to cache a hugging face repository without matching files", func() {
+			ctx := context.TODO()
+			hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"sha":"0e9e39f249a16976918f6564b8830bc894c89659","siblings":[{"rfilename":"config.json"}]}`))
+			}))
+			defer hub.Close()
+
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{HuggingFace: &appsv1alpha1.HuggingFaceSource{
+						RepoID:      "meta-llama/Llama-3.1-8B-Instruct",
+						Include:     []string{"*.safetensors"},
+						Endpoint:    hub.URL,
+						ModelPuller: "nvcr.io/nim/huggingface-cli:latest",
+					}},
+				},
+			}
+			err := reconciler.reconcileHuggingFace(ctx, nimCache)
+			Expect(err).To(MatchError(ContainSubstring("no files of model repository")))
+			Expect(nimCache.Status.HuggingFace).To(BeNil())
+		})
+
+		It("should sync an s3 prefix with the operator image and report the transfer", func() {
+			ctx := context.TODO()
+			DeferCleanup(os.Setenv, "OPERATOR_IMAGE", os.Getenv("OPERATOR_IMAGE"))
+			Expect(os.Setenv("OPERATOR_IMAGE", "nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0")).To(Succeed())
+
+			Expect(cli.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "minio-ca", Namespace: "default"},
+				Data:       map[string]string{"ca.crt": "certificate"},
+			})).To(Succeed())
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{S3: &appsv1alpha1.S3Source{
+						Endpoint:          "https://minio.example.com",
+						Bucket:            "models",
+						Prefix:            "llama/",
+						CredentialsSecret: "minio-credentials",
+					}},
+					CertConfig: &appsv1alpha1.CertConfig{Name: "minio-ca", MountPath: "/etc/minio/certs"},
+				},
+			}
+
+			job, err := reconciler.constructJob(ctx, nimCache, k8sutil.K8s)
+			Expect(err).ToNot(HaveOccurred())
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0"))
+			Expect(container.Command).To(Equal([]string{"/manager"}))
+			Expect(container.Args).To(Equal([]string{
+				`--cache-source={"s3":{"endpoint":"https://minio.example.com","bucket":"models","prefix":"llama/","credentialsSecret":"minio-credentials"}}`,
+				"--cache-path=/model-store",
+			}))
+			Expect(container.EnvFrom).To(ContainElement(HaveField("SecretRef.LocalObjectReference.Name", "minio-credentials")))
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "SSL_CERT_DIR", Value: "/etc/minio/certs"}))
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "cert-volume", MountPath: "/etc/minio/certs/ca.crt", SubPath: "ca.crt"}))
+
+			// The transfer is reported in the termination message of the succeeded pod
+			Expect(cli.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-nimcache-job-abcde", Namespace: "default", Labels: map[string]string{"job-name": "test-nimcache-job"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: NIMCacheContainerName, Image: container.Image}}},
+				Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  NIMCacheContainerName,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: `{"s3":{"objects":3,"verifiedObjects":2,"bytesTransferred":16106127360}}`}},
+				}}},
+			})).To(Succeed())
+			job.Status.Succeeded = 1
+			Expect(reconciler.reconcileJobStatus(ctx, nimCache, job)).To(Succeed())
+			Expect(nimCache.Status.State).To(Equal(appsv1alpha1.NimCacheStatusReady))
+			Expect(nimCache.Status.S3).To(Equal(&appsv1alpha1.S3Status{Objects: 3, VerifiedObjects: 2, BytesTransferred: 16106127360}))
+		})
+
+		It("should resolve the oci artifact digest and pin the caching job to it", func() {
+			ctx := context.TODO()
+			DeferCleanup(os.Setenv, "OPERATOR_IMAGE", os.Getenv("OPERATOR_IMAGE"))
+			Expect(os.Setenv("OPERATOR_IMAGE", "nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0")).To(Succeed())
+
+			manifest := `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[` +
+				`{"mediaType":"application/octet-stream","digest":"sha256:0123","size":7,"annotations":{"org.opencontainers.image.title":"model.safetensors"}}]}`
+			requests := 0
+			registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+					w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if !strings.HasPrefix(r.URL.Path, "/v2/models/llama3/manifests/") {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(manifest))
+			}))
+			defer registry.Close()
+			host := strings.TrimPrefix(registry.URL, "http://")
+
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "registry-secret", Namespace: "default"},
+				Type:       corev1.SecretTypeDockerConfigJson,
+				Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"` + host + `":{"auth":"dXNlcjpwYXNz"}}}`)},
+			})).To(Succeed())
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{OCI: &appsv1alpha1.OCISource{
+						Reference:   host + "/models/llama3:1.0",
+						PullSecrets: []string{"registry-secret"},
+						PlainHTTP:   true,
+					}},
+					Storage: appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+				},
+			}
+			Expect(cli.Create(ctx, nimCache)).To(Succeed())
+
+			_, err := reconciler.reconcileNIMCache(ctx, nimCache)
+			Expect(err).ToNot(HaveOccurred())
+
+			digest := fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(manifest)))
+			updated := &appsv1alpha1.NIMCache{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache", Namespace: "default"}, updated)).To(Succeed())
+			Expect(updated.Status.OCI.Reference).To(Equal(host + "/models/llama3:1.0"))
+			Expect(updated.Status.OCI.Digest).To(Equal(digest))
+			Expect(updated.Status.OCI.Files).To(Equal([]string{"model.safetensors"}))
+			Expect(updated.Status.OCI.Size).To(Equal(int64(7)))
+			Expect(updated.Status.OCI.SourceHash).ToNot(BeEmpty())
+
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-job", Namespace: "default"}, job)).To(Succeed())
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("nvcr.io/nvidia/cloud-native/k8s-nim-operator:v1.0.0"))
+			Expect(container.Args).To(Equal([]string{
+				`--cache-source={"oci":{"reference":"` + host + `/models/llama3@` + digest + `","plainHTTP":true}}`,
+				"--cache-path=/model-store",
+			}))
+			Expect(job.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Secret.SecretName", "registry-secret")))
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "registry-auth-0", MountPath: "/etc/nim/registry-auth/registry-secret", ReadOnly: true}))
+
+			// The digest is resolved again only when the source changes
+			resolved := requests
+			Expect(reconciler.reconcileOCI(ctx, updated)).To(Succeed())
+			Expect(requests).To(Equal(resolved))
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "registry-secret-rotated", Namespace: "default"},
+				Type:       corev1.SecretTypeDockerConfigJson,
+				Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"` + host + `":{"auth":"dXNlcjpwYXNz"}}}`)},
+			})).To(Succeed())
+			sourceHash := updated.Status.OCI.SourceHash
+			updated.Spec.Source.OCI.PullSecrets = []string{"registry-secret-rotated"}
+			Expect(reconciler.reconcileOCI(ctx, updated)).To(Succeed())
+			Expect(requests).To(BeNumerically(">", resolved))
+			Expect(updated.Status.OCI.SourceHash).ToNot(Equal(sourceHash))
+
+			// The artifact is not cached again while the digest is unchanged
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-job", Namespace: "default"}, job)).To(Succeed())
+
+			// A new digest is cached again
+			job.Status.Succeeded = 1
+			Expect(reconciler.reconcileJobStatus(ctx, updated, job)).To(Succeed())
+			Expect(updated.Status.State).To(Equal(appsv1alpha1.NimCacheStatusReady))
+			manifest = `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[]}`
+			updated.Spec.Source.OCI.Reference = host + "/models/llama3:2.0"
+			Expect(reconciler.reconcileOCI(ctx, updated)).To(Succeed())
+			Expect(updated.Status.OCI.Digest).To(Equal(fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(manifest)))))
+			Expect(updated.Status.State).To(Equal(appsv1alpha1.NimCacheStatusNotReady))
+			err = cli.Get(ctx, types.NamespacedName{Name: "test-nimcache-job", Namespace: "default"}, job)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
 		It("should create a ConfigMap with the given model manifest data", func() {
 			ctx := context.TODO()
 			nimCache := &appsv1alpha1.NIMCache{
@@ -704,6 +1486,7 @@ var _ = Describe("NIMCache Controller", func() {
 				Client:   cli,
 				scheme:   scheme,
 				recorder: record.NewFakeRecorder(1000),
+				Platform: &standalone.Standalone{},
 			}
 
 		})
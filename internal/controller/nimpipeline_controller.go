@@ -23,9 +23,11 @@ import (
 
 	utils "github.com/NVIDIA/k8s-nim-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -167,6 +169,16 @@ func (r *NIMPipelineReconciler) injectDependencies(nimService *appsv1alpha1.NIMS
 			// Merge and inject the environment variables
 			nimService.Spec.Env = utils.MergeEnvVars(nimService.Spec.Env, serviceEnvVars)
 		}
+
+		// Allow traffic to the pods of all revisions of the dependent service when the network policy is enabled
+		if nimService.IsNetworkPolicyEnabled() {
+			port := intstr.FromInt32(dep.Port)
+			protocol := corev1.ProtocolTCP
+			nimService.Spec.NetworkPolicy.Egress = append(nimService.Spec.NetworkPolicy.Egress, networkingv1.NetworkPolicyEgressRule{
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: &protocol, Port: &port}},
+				To:    []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/instance": dep.Name}}}},
+			})
+		}
 	}
 }
 
@@ -291,5 +291,45 @@ var _ = Describe("NIMPipeline Controller", func() {
 			}
 			validateEnvVars("nim-llm-service", expectedEnvVarsForLLM)
 		})
+
+		It("Should allow egress to dependencies when the network policy is enabled", func() {
+			ctx := context.TODO()
+			nimPipeline := &appsv1alpha1.NIMPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pipeline",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMPipelineSpec{
+					Services: []appsv1alpha1.NIMServicePipelineSpec{
+						{
+							Name:    "nim-llm-service",
+							Enabled: utils.BoolPtr(true),
+							Spec: appsv1alpha1.NIMServiceSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "llm-nim-container",
+									Tag:        "latest",
+								},
+								Replicas:      1,
+								NetworkPolicy: appsv1alpha1.NetworkPolicy{Enabled: utils.BoolPtr(true)},
+							},
+							Dependencies: []appsv1alpha1.ServiceDependency{
+								{Name: "nim-embedding-service", Port: 9090},
+							},
+						},
+					},
+				},
+			}
+			Expect(client.Create(ctx, nimPipeline)).To(Succeed())
+
+			_, err := reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+
+			nimService := &appsv1alpha1.NIMService{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "nim-llm-service", Namespace: "default"}, nimService)).To(Succeed())
+			Expect(nimService.Spec.NetworkPolicy.Egress).To(HaveLen(1))
+			egress := nimService.Spec.NetworkPolicy.Egress[0]
+			Expect(egress.Ports[0].Port.IntValue()).To(Equal(9090))
+			Expect(egress.To[0].PodSelector.MatchLabels).To(Equal(map[string]string{"app.kubernetes.io/instance": "nim-embedding-service"}))
+		})
 	})
 })
@@ -30,18 +30,24 @@ import (
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 // NIMServiceFinalizer is the finalizer annotation
@@ -79,6 +85,7 @@ func NewNIMServiceReconciler(client client.Client, scheme *runtime.Scheme, updat
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimservices/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimservices/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimcaches,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimoperatorconfigs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=config.openshift.io,resources=clusterversions;proxies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=use,resourceNames=nonroot
@@ -86,12 +93,20 @@ func NewNIMServiceReconciler(client client.Client, scheme *runtime.Scheme, updat
 // +kubebuilder:rbac:groups="",resources=serviceaccounts;pods;pods/eviction;services;services/finalizers;endpoints,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims;configmaps;secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=leaderworkerset.x-k8s.io,resources=leaderworkersets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;prometheusrules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=resource.k8s.io,resources=resourceclaimtemplates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalars,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -148,19 +163,28 @@ func (r *NIMServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
+	// Apply the operator defaults to the fields unset in the NIMService spec, the spec is not persisted
+	operatorConfig, err := shared.GetOperatorConfig(ctx, r.GetClient())
+	if err != nil {
+		logger.Error(err, "unable to get the operator config")
+		return ctrl.Result{}, err
+	}
+	operatorConfig.ApplyToNIMService(nimService)
+
 	// Fetch container orchestrator type
-	_, err := r.GetOrchestratorType()
+	_, err = r.GetOrchestratorType()
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("Unable to get container orchestrator type, %v", err)
 	}
 
 	// Handle platform-specific reconciliation
-	if result, err := r.Platform.Sync(ctx, r, nimService); err != nil {
+	result, err := r.Platform.Sync(ctx, r, nimService)
+	if err != nil {
 		logger.Error(err, "error reconciling NIMService", "name", nimService.Name)
 		return result, err
 	}
 
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
 // GetScheme returns the scheme of the reconciler
@@ -218,33 +242,120 @@ func (r *NIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&appsv1alpha1.NIMService{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&appsv1.StatefulSet{}).
+		Owns(&batchv1.Job{}).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&rbacv1.Role{}).
 		Owns(&rbacv1.RoleBinding{}).
 		Owns(&networkingv1.Ingress{}).
 		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
-		WithEventFilter(predicate.Funcs{
-			UpdateFunc: func(e event.UpdateEvent) bool {
-				// Type assert to NIMService
-				if oldNIMService, ok := e.ObjectOld.(*appsv1alpha1.NIMService); ok {
-					newNIMService := e.ObjectNew.(*appsv1alpha1.NIMService)
-
-					// Handle case where object is marked for deletion
-					if !newNIMService.ObjectMeta.DeletionTimestamp.IsZero() {
-						return true
-					}
-
-					// Handle only spec updates
-					return !reflect.DeepEqual(oldNIMService.Spec, newNIMService.Spec)
-				}
-				// For other types we watch, reconcile them
-				return true
-			},
-		}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(r.mapNodeToNIMServices), builder.WithPredicates(nodeGPUPredicate())).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.mapSecretToNIMServices), builder.WithPredicates(tlsSecretPredicate())).
+		Watches(&appsv1alpha1.NIMOperatorConfig{}, shared.EnqueueRequestsForOperatorConfig(mgr.GetClient(), &appsv1alpha1.NIMServiceList{}), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithEventFilter(nimServiceUpdatePredicate()).
 		Complete(r)
 }
 
+// mapNodeToNIMServices requeues the NIMServices selecting their profile automatically when the GPU nodes change
+func (r *NIMServiceReconciler) mapNodeToNIMServices(ctx context.Context, _ client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	nimServiceList := &appsv1alpha1.NIMServiceList{}
+	err := r.Client.List(ctx, nimServiceList)
+	if err != nil {
+		logger.Error(err, "unable to list nimServices in the cluster")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, nimService := range nimServiceList.Items {
+		if nimService.IsAutoProfileSelectionEnabled() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()},
+			})
+		}
+	}
+	return requests
+}
+
+// mapSecretToNIMServices requeues the NIMServices serving TLS with the certificate from the secret
+func (r *NIMServiceReconciler) mapSecretToNIMServices(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	nimServiceList := &appsv1alpha1.NIMServiceList{}
+	err := r.Client.List(ctx, nimServiceList, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		logger.Error(err, "unable to list nimServices in the namespace", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, nimService := range nimServiceList.Items {
+		if nimService.IsTLSEnabled() && nimService.GetTLSSecretName() == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()},
+			})
+		}
+	}
+	return requests
+}
+
+// nimServiceUpdatePredicate filters NIMService updates to spec changes, deletions and wake requests from the activator
+func nimServiceUpdatePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Type assert to NIMService
+			if oldNIMService, ok := e.ObjectOld.(*appsv1alpha1.NIMService); ok {
+				newNIMService := e.ObjectNew.(*appsv1alpha1.NIMService)
+
+				// Handle case where object is marked for deletion
+				if !newNIMService.ObjectMeta.DeletionTimestamp.IsZero() {
+					return true
+				}
+
+				// Handle wake requests from the activator of an idle NIMService
+				if oldNIMService.GetAnnotations()[appsv1alpha1.NIMServiceWakeAnnotationKey] != newNIMService.GetAnnotations()[appsv1alpha1.NIMServiceWakeAnnotationKey] {
+					return true
+				}
+
+				// Handle only spec updates
+				return !reflect.DeepEqual(oldNIMService.Spec, newNIMService.Spec)
+			}
+			// For other types we watch, reconcile them
+			return true
+		},
+	}
+}
+
+// tlsSecretPredicate filters secret events to the TLS secrets
+func tlsSecretPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		secret, ok := obj.(*corev1.Secret)
+		return ok && secret.Type == corev1.SecretTypeTLS
+	})
+}
+
+// nodeGPUPredicate filters node updates to the changes affecting the GPUs available for scheduling
+func nodeGPUPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, ok := e.ObjectOld.(*corev1.Node)
+			if !ok {
+				return false
+			}
+			newNode := e.ObjectNew.(*corev1.Node)
+			return oldNode.Spec.Unschedulable != newNode.Spec.Unschedulable ||
+				!reflect.DeepEqual(oldNode.Labels, newNode.Labels) ||
+				!reflect.DeepEqual(oldNode.Status.Allocatable, newNode.Status.Allocatable)
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
 func (r *NIMServiceReconciler) refreshMetrics(ctx context.Context) {
 	logger := log.FromContext(ctx)
 	// List all nodes
@@ -18,22 +18,37 @@ package controller
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/activator"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
 	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/standalone"
 )
 
@@ -119,4 +134,86 @@ var _ = Describe("NIMService Controller", func() {
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
 	})
+
+	Context("When an idle NIMService receives a request", func() {
+		ctx := context.Background()
+		namespacedName := types.NamespacedName{Name: "test-nimservice", Namespace: "default"}
+
+		BeforeEach(func() {
+			Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+			Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+			Expect(autoscalingv2.AddToScheme(scheme)).To(Succeed())
+			Expect(networkingv1.AddToScheme(scheme)).To(Succeed())
+			Expect(policyv1.AddToScheme(scheme)).To(Succeed())
+			Expect(resourcev1alpha3.AddToScheme(scheme)).To(Succeed())
+			reconciler.updater = conditions.NewUpdater(client)
+
+			origManifestsDir := standalone.ManifestsDir
+			standalone.ManifestsDir = filepath.Join("..", "..", "manifests")
+			DeferCleanup(func() {
+				standalone.ManifestsDir = origManifestsDir
+			})
+		})
+
+		It("should resume the NIMService on the wake request of the activator", func() {
+			nimService := &appsv1alpha1.NIMService{
+				ObjectMeta: metav1.ObjectMeta{Name: namespacedName.Name, Namespace: namespacedName.Namespace},
+				Spec: appsv1alpha1.NIMServiceSpec{
+					Image: appsv1alpha1.Image{Repository: "nvcr.io/nim/meta/llama3-8b-instruct", Tag: "1.0.0"},
+					Storage: appsv1alpha1.NIMServiceStorage{
+						PVC: appsv1alpha1.PersistentVolumeClaim{Name: "test-pvc"},
+					},
+					Expose: appsv1alpha1.NIMServiceExpose{Expose: appsv1alpha1.Expose{Service: appsv1alpha1.Service{Port: 8000}}},
+					ScaleToZero: &appsv1alpha1.ScaleToZeroSpec{
+						PrometheusURL: "http://prometheus.monitoring.svc:9090",
+						Activator:     appsv1alpha1.ActivatorSpec{Image: "ghcr.io/nvidia/k8s-nim-operator:main"},
+					},
+				},
+			}
+			Expect(client.Create(ctx, nimService)).To(Succeed())
+			suspendedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+			nimService.Status.Suspension = &appsv1alpha1.SuspensionStatus{
+				Suspended:   true,
+				Reason:      appsv1alpha1.SuspendReasonIdle,
+				SuspendedAt: &suspendedAt,
+			}
+			Expect(client.Status().Update(ctx, nimService)).To(Succeed())
+
+			By("keeping the NIMService suspended without requests")
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: namespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).NotTo(BeZero())
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(ctx, namespacedName, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(0)))
+
+			By("requesting the NIMService to resume from the activator")
+			suspended := &appsv1alpha1.NIMService{}
+			Expect(client.Get(ctx, namespacedName, suspended)).To(Succeed())
+			target, err := url.Parse("http://test-nimservice.default.svc:8000")
+			Expect(err).NotTo(HaveOccurred())
+			a := activator.NewActivator(client, namespacedName, target, nil, 100*time.Millisecond)
+			recorder := httptest.NewRecorder()
+			a.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/v1/chat/completions", nil))
+			Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+
+			woken := &appsv1alpha1.NIMService{}
+			Expect(client.Get(ctx, namespacedName, woken)).To(Succeed())
+			Expect(woken.GetAnnotations()).To(HaveKey(appsv1alpha1.NIMServiceWakeAnnotationKey))
+			Expect(nimServiceUpdatePredicate().Update(event.UpdateEvent{ObjectOld: suspended, ObjectNew: woken})).To(BeTrue())
+
+			By("resuming the NIMService on the next reconcile")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: namespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Get(ctx, namespacedName, woken)).To(Succeed())
+			Expect(woken.IsSuspended()).To(BeFalse())
+			Expect(client.Get(ctx, namespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).NotTo(Equal(ptr.To[int32](0)))
+
+			// Status-only updates are still filtered
+			updated := woken.DeepCopy()
+			updated.Status.State = "Ready"
+			Expect(nimServiceUpdatePredicate().Update(event.UpdateEvent{ObjectOld: woken, ObjectNew: updated})).To(BeFalse())
+		})
+	})
 })
@@ -20,14 +20,36 @@ import (
 	"context"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
 	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// ManifestsDir is the directory to render k8s resource manifests
+	ManifestsDir = "/manifests"
+)
+
+var (
+	// ServingRuntimeGVK is the GroupVersionKind of the KServe ServingRuntime
+	ServingRuntimeGVK = schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1alpha1", Kind: "ServingRuntime"}
+	// InferenceServiceGVK is the GroupVersionKind of the KServe InferenceService
+	InferenceServiceGVK = schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService"}
+	// LocalModelCacheGVK is the GroupVersionKind of the KServe LocalModelCache
+	LocalModelCacheGVK = schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1alpha1", Kind: "LocalModelCache"}
+)
+
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices;servingruntimes;localmodelcaches,verbs=get;list;watch;create;update;patch;delete
+
 // KServe implements the Platform interface for KServe
 type KServe struct{}
 
@@ -35,34 +57,45 @@ type KServe struct{}
 
 // NIMCacheReconciler represents the NIMCache reconciler instance for KServe platform
 type NIMCacheReconciler struct {
-	shared.Reconciler
 	client.Client
-	scheme *runtime.Scheme
-	log    logr.Logger
+	scheme   *runtime.Scheme
+	log      logr.Logger
+	renderer render.Renderer
+	recorder record.EventRecorder
 }
 
 // NIMServiceReconciler represents the NIMService reconciler instance for KServe platform
 type NIMServiceReconciler struct {
 	client.Client
-	scheme *runtime.Scheme
-	log    logr.Logger
+	scheme           *runtime.Scheme
+	log              logr.Logger
+	updater          conditions.Updater
+	renderer         render.Renderer
+	recorder         record.EventRecorder
+	orchestratorType k8sutil.OrchestratorType
 }
 
 // NewNIMCacheReconciler returns NIMCacheReconciler for KServe platform
 func NewNIMCacheReconciler(r shared.Reconciler) *NIMCacheReconciler {
 	return &NIMCacheReconciler{
-		Client: r.GetClient(),
-		scheme: r.GetScheme(),
-		log:    r.GetLogger(),
+		Client:   r.GetClient(),
+		scheme:   r.GetScheme(),
+		log:      r.GetLogger(),
+		recorder: r.GetEventRecorder(),
 	}
 }
 
 // NewNIMServiceReconciler returns NIMServiceReconciler for KServe platform
 func NewNIMServiceReconciler(r shared.Reconciler) *NIMServiceReconciler {
+	orchestratorType, _ := r.GetOrchestratorType()
+
 	return &NIMServiceReconciler{
-		Client: r.GetClient(),
-		scheme: r.GetScheme(),
-		log:    r.GetLogger(),
+		Client:           r.GetClient(),
+		scheme:           r.GetScheme(),
+		log:              r.GetLogger(),
+		updater:          r.GetUpdater(),
+		recorder:         r.GetEventRecorder(),
+		orchestratorType: orchestratorType,
 	}
 }
 
@@ -79,12 +112,51 @@ func (k *KServe) Delete(ctx context.Context, r shared.Reconciler, resource clien
 		}
 		return nil
 	}
+
+	if nimCache, ok := resource.(*appsv1alpha1.NIMCache); ok {
+		reconciler := NewNIMCacheReconciler(r)
+		err := reconciler.cleanupNIMCache(ctx, nimCache)
+		if err != nil {
+			logger.Error(err, "failed to cleanup nimcache resources", "name", nimCache.Name)
+			return err
+		}
+		return nil
+	}
 	return errors.NewBadRequest("invalid resource type")
 
 }
 
 // Sync handles reconciliation of Kserve resources
 func (k *KServe) Sync(ctx context.Context, r shared.Reconciler, resource client.Object) (ctrl.Result, error) {
-	// TODO: add reconciliation logic specific to Kserve modelcache
-	return ctrl.Result{}, nil
+	logger := r.GetLogger()
+
+	if nimService, ok := resource.(*appsv1alpha1.NIMService); ok {
+		reconciler := NewNIMServiceReconciler(r)
+		reconciler.renderer = render.NewRenderer(ManifestsDir)
+		logger.Info("Reconciling NIMService instance with KServe")
+		result, err := reconciler.reconcileNIMService(ctx, nimService)
+		if err != nil {
+			r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, "ReconcileFailed",
+				"NIMService %s failed, msg: %s", nimService.Name, err.Error())
+			errConditionUpdate := reconciler.updater.SetConditionsFailed(ctx, nimService, conditions.Failed, err.Error())
+			if errConditionUpdate != nil {
+				logger.Error(err, "Unable to update status")
+				return result, errConditionUpdate
+			}
+		}
+		return result, err
+	}
+
+	if nimCache, ok := resource.(*appsv1alpha1.NIMCache); ok {
+		reconciler := NewNIMCacheReconciler(r)
+		reconciler.renderer = render.NewRenderer(ManifestsDir)
+		logger.Info("Reconciling NIMCache instance with KServe")
+		result, err := reconciler.reconcileNIMCache(ctx, nimCache)
+		if err != nil {
+			r.GetEventRecorder().Eventf(nimCache, corev1.EventTypeWarning, "ReconcileFailed",
+				"NIMCache %s failed, msg: %s", nimCache.Name, err.Error())
+		}
+		return result, err
+	}
+	return ctrl.Result{}, errors.NewBadRequest("invalid resource type")
 }
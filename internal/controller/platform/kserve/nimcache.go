@@ -0,0 +1,147 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kserve
+
+import (
+	"context"
+	"fmt"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func (r *NIMCacheReconciler) cleanupNIMCache(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
+	logger := log.FromContext(ctx)
+
+	// LocalModelCache is cluster-scoped and cannot be garbage collected with the NIMCache instance
+	localModelCache := newUnstructured(LocalModelCacheGVK)
+	err := r.Get(ctx, types.NamespacedName{Name: nimCache.GetLocalModelCacheName()}, localModelCache)
+	if err != nil {
+		// Nothing to cleanup if the LocalModelCache CRD is not installed
+		if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := r.Delete(ctx, localModelCache); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	logger.Info("Deleted KServe LocalModelCache", "name", localModelCache.GetName())
+	return nil
+}
+
+func (r *NIMCacheReconciler) reconcileNIMCache(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Models are published only once caching is complete
+	if nimCache.Status.State != appsv1alpha1.NimCacheStatusReady {
+		return ctrl.Result{}, nil
+	}
+
+	// Publish the storage URI for InferenceServices to reference the cached model
+	storageURI := nimCache.GetStorageURI()
+	if nimCache.Status.StorageURI != storageURI {
+		obj := &appsv1alpha1.NIMCache{}
+		if err := r.Get(ctx, types.NamespacedName{Name: nimCache.GetName(), Namespace: nimCache.GetNamespace()}, obj); err != nil {
+			return ctrl.Result{}, err
+		}
+		obj.Status.StorageURI = storageURI
+		if err := r.Status().Update(ctx, obj); err != nil {
+			logger.Error(err, "Failed to update storage URI", "NIMCache", nimCache.Name)
+			return ctrl.Result{}, err
+		}
+		nimCache.Status.StorageURI = storageURI
+		logger.Info("Published storage URI for the cached model", "NIMCache", nimCache.Name, "storageURI", storageURI)
+	}
+
+	if !nimCache.IsLocalModelCacheEnabled() {
+		return ctrl.Result{}, r.cleanupNIMCache(ctx, nimCache)
+	}
+
+	modelSize, err := r.getModelSize(ctx, nimCache)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	localModelCache, err := r.renderer.LocalModelCache(&rendertypes.LocalModelCacheParams{
+		Name: nimCache.GetLocalModelCacheName(),
+		Labels: map[string]string{
+			"app.kubernetes.io/name":       nimCache.GetName(),
+			"app.kubernetes.io/instance":   nimCache.GetName(),
+			"app.kubernetes.io/managed-by": "k8s-nim-operator",
+		},
+		SourceModelURI: storageURI,
+		ModelSize:      modelSize,
+		NodeGroups:     nimCache.Spec.KServe.LocalModelCache.NodeGroups,
+	})
+	if err != nil {
+		logger.Error(err, "failed to render", "localmodelcache", nimCache.GetLocalModelCacheName())
+		return ctrl.Result{}, err
+	}
+
+	current := newUnstructured(LocalModelCacheGVK)
+	err = r.Get(ctx, types.NamespacedName{Name: localModelCache.GetName()}, current)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	if !utils.IsSpecChanged(current, localModelCache) {
+		return ctrl.Result{}, nil
+	}
+
+	if errors.IsNotFound(err) {
+		err = r.Create(ctx, localModelCache)
+	} else {
+		localModelCache.SetResourceVersion(current.GetResourceVersion())
+		err = r.Update(ctx, localModelCache)
+	}
+	if err != nil {
+		logger.Error(err, "failed to sync", "localmodelcache", localModelCache.GetName())
+		return ctrl.Result{}, err
+	}
+	logger.Info("Registered KServe LocalModelCache", "name", localModelCache.GetName(), "sourceModelUri", storageURI)
+	return ctrl.Result{}, nil
+}
+
+// getModelSize returns the size to reserve on nodes for the LocalModelCache
+func (r *NIMCacheReconciler) getModelSize(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (string, error) {
+	if size := nimCache.Spec.KServe.LocalModelCache.ModelSize; size != nil {
+		return size.String(), nil
+	}
+	if nimCache.Spec.Storage.PVC.Size != "" {
+		return nimCache.Spec.Storage.PVC.Size, nil
+	}
+
+	// Fall back to the requested size of an existing PVC
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nimCache.Status.PVC, Namespace: nimCache.GetNamespace()}, pvc); err != nil {
+		return "", err
+	}
+	size, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if !ok {
+		return "", fmt.Errorf("unable to determine model size for localmodelcache from pvc %s", pvc.Name)
+	}
+	return size.String(), nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kserve
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("NIMCacheReconciler for the KServe platform", func() {
+	var (
+		client     client.Client
+		reconciler *NIMCacheReconciler
+		nimCache   *appsv1alpha1.NIMCache
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(appsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		client = fake.NewClientBuilder().WithScheme(scheme).
+			WithStatusSubresource(&appsv1alpha1.NIMCache{}).
+			Build()
+		cwd, err := os.Getwd()
+		if err != nil {
+			panic(err)
+		}
+
+		reconciler = &NIMCacheReconciler{
+			Client:   client,
+			scheme:   scheme,
+			renderer: render.NewRenderer(path.Join(strings.TrimSuffix(cwd, "internal/controller/platform/kserve"), "manifests")),
+			recorder: record.NewFakeRecorder(1000),
+		}
+
+		nimCache = &appsv1alpha1.NIMCache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-nimcache",
+				Namespace: "default",
+			},
+			Spec: appsv1alpha1.NIMCacheSpec{
+				Storage: appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Size: "50Gi", SubPath: "/models/"}},
+			},
+		}
+		Expect(client.Create(context.TODO(), nimCache)).To(Succeed())
+		nimCache.Status = appsv1alpha1.NIMCacheStatus{
+			State: appsv1alpha1.NimCacheStatusReady,
+			PVC:   "test-nimcache-pvc",
+		}
+		Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+	})
+
+	Describe("Reconcile", func() {
+		It("should not publish the storage URI until caching is complete", func() {
+			nimCache.Status.State = appsv1alpha1.NimCacheStatusInProgress
+			_, err := reconciler.reconcileNIMCache(context.TODO(), nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimCache.Status.StorageURI).To(BeEmpty())
+		})
+
+		It("should publish the storage URI of the cached model", func() {
+			_, err := reconciler.reconcileNIMCache(context.TODO(), nimCache)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj := &appsv1alpha1.NIMCache{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimCache.Name, Namespace: nimCache.Namespace}, obj)).To(Succeed())
+			Expect(obj.Status.StorageURI).To(Equal("pvc://test-nimcache-pvc/models"))
+
+			// No LocalModelCache unless requested
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimCache.GetLocalModelCacheName()}, newUnstructured(LocalModelCacheGVK))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should register and cleanup the LocalModelCache when enabled", func() {
+			nimCache.Spec.KServe = &appsv1alpha1.KServeCacheSpec{
+				LocalModelCache: &appsv1alpha1.LocalModelCacheSpec{NodeGroups: []string{"gpu-nodes"}},
+			}
+			_, err := reconciler.reconcileNIMCache(context.TODO(), nimCache)
+			Expect(err).NotTo(HaveOccurred())
+
+			localModelCache := newUnstructured(LocalModelCacheGVK)
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: "default-test-nimcache"}, localModelCache)).To(Succeed())
+			sourceModelURI, _, _ := unstructured.NestedString(localModelCache.Object, "spec", "sourceModelUri")
+			Expect(sourceModelURI).To(Equal("pvc://test-nimcache-pvc/models"))
+			modelSize, _, _ := unstructured.NestedString(localModelCache.Object, "spec", "modelSize")
+			Expect(modelSize).To(Equal("50Gi"))
+			nodeGroups, _, _ := unstructured.NestedStringSlice(localModelCache.Object, "spec", "nodeGroups")
+			Expect(nodeGroups).To(Equal([]string{"gpu-nodes"}))
+
+			// Disabling the LocalModelCache removes it
+			nimCache.Spec.KServe = nil
+			_, err = reconciler.reconcileNIMCache(context.TODO(), nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), types.NamespacedName{Name: "default-test-nimcache"}, newUnstructured(LocalModelCacheGVK))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -18,11 +18,607 @@ package kserve
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"time"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+const (
+	// ReasonServingRuntimeFailed indicates that the creation of servingruntime has failed
+	ReasonServingRuntimeFailed = "ServingRuntimeFailed"
+	// ReasonInferenceServiceFailed indicates that the creation of inferenceservice has failed
+	ReasonInferenceServiceFailed = "InferenceServiceFailed"
+)
+
+// GetScheme returns the scheme of the reconciler
+func (r *NIMServiceReconciler) GetScheme() *runtime.Scheme {
+	return r.scheme
+}
+
+// GetLogger returns the logger of the reconciler
+func (r *NIMServiceReconciler) GetLogger() logr.Logger {
+	return r.log
+}
+
+// GetClient returns the client instance
+func (r *NIMServiceReconciler) GetClient() client.Client {
+	return r.Client
+}
+
+// GetUpdater returns the conditions updater instance
+func (r *NIMServiceReconciler) GetUpdater() conditions.Updater {
+	return r.updater
+}
+
+// GetRenderer returns the renderer instance
+func (r *NIMServiceReconciler) GetRenderer() render.Renderer {
+	return r.renderer
+}
+
+// GetEventRecorder returns the event recorder
+func (r *NIMServiceReconciler) GetEventRecorder() record.EventRecorder {
+	return r.recorder
+}
+
+// GetOrchestratorType returns the container platform type
+func (r *NIMServiceReconciler) GetOrchestratorType() k8sutil.OrchestratorType {
+	return r.orchestratorType
+}
+
 func (r *NIMServiceReconciler) cleanupNIMService(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
-	// TODO: add cleanup logic specific to Kserve modelcache
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+
+	// Delete the InferenceService before its ServingRuntime so that KServe can tear down the predictor.
+	// Remaining dependent (owned) objects will be automatically garbage collected.
+	if err := r.cleanupResource(ctx, newUnstructured(InferenceServiceGVK), namespacedName); err != nil {
+		return err
+	}
+	return r.cleanupResource(ctx, newUnstructured(ServingRuntimeGVK), namespacedName)
+}
+
+func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimService *appsv1alpha1.NIMService) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	var err error
+	defer func() {
+		if err != nil {
+			r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, conditions.Failed,
+				"NIMService %s failed, msg: %s", nimService.Name, err.Error())
+		}
+	}()
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+
+	// Multi-node deployments are rendered as a LeaderWorkerSet, only supported in standalone mode
+	if nimService.IsMultiNodeEnabled() {
+		err = fmt.Errorf("multi-node deployments are not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	// KServe manages the predictor pods, statefulsets are only supported in standalone mode
+	if nimService.GetDeploymentKind() == appsv1alpha1.DeploymentKindStatefulSet {
+		err = fmt.Errorf("deploymentKind %s is not supported with the kserve platform", appsv1alpha1.DeploymentKindStatefulSet)
+		return ctrl.Result{}, err
+	}
+	// KServe manages revisions of the predictor, progressive rollouts are only supported in standalone mode
+	if nimService.IsRolloutEnabled() {
+		err = fmt.Errorf("rollout is not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	// LoRA adapters are downloaded to shared storage, only supported in standalone mode
+	if nimService.IsLoRAEnabled() {
+		err = fmt.Errorf("LoRA adapters are not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	// Scaling to zero is configured on the KServe InferenceService, only supported in standalone mode
+	if nimService.Spec.Suspend || nimService.IsScaleToZeroEnabled() {
+		err = fmt.Errorf("suspend and scaleToZero are not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	// KServe scales the predictor with its own autoscaler
+	if nimService.IsKEDAEnabled() {
+		err = fmt.Errorf("KEDA autoscaling is not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	// The InferenceService predictor requests GPUs through the GPU resource
+	if nimService.IsDRAEnabled() {
+		err = fmt.Errorf("dra is not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	// KServe may schedule the predictor on any node, the profile cannot be selected for the available GPUs
+	if nimService.IsAutoProfileSelectionEnabled() {
+		err = fmt.Errorf("automatic profile selection is not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	// KServe owns the predictor pods and their disruption budget
+	if nimService.IsPDBEnabled() {
+		err = fmt.Errorf("pdb is not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	if nimService.IsNetworkPolicyEnabled() {
+		err = fmt.Errorf("networkPolicy is not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	if nimService.IsGatewayRouteEnabled() {
+		err = fmt.Errorf("gatewayRoute is not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+	if nimService.IsTLSEnabled() {
+		err = fmt.Errorf("tls is not supported with the kserve platform")
+		return ctrl.Result{}, err
+	}
+
+	renderer := r.GetRenderer()
+
+	// Sync serviceaccount
+	err = r.renderAndSyncResource(ctx, nimService, &corev1.ServiceAccount{}, func() (client.Object, error) {
+		return renderer.ServiceAccount(nimService.GetServiceAccountParams())
+	}, "serviceaccount", conditions.ReasonServiceAccountFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync role
+	err = r.renderAndSyncResource(ctx, nimService, &rbacv1.Role{}, func() (client.Object, error) {
+		return renderer.Role(nimService.GetRoleParams())
+	}, "role", conditions.ReasonRoleFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync rolebinding
+	err = r.renderAndSyncResource(ctx, nimService, &rbacv1.RoleBinding{}, func() (client.Object, error) {
+		return renderer.RoleBinding(nimService.GetRoleBindingParams())
+	}, "rolebinding", conditions.ReasonRoleBindingFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	servingRuntimeParams := nimService.GetServingRuntimeParams()
+	inferenceServiceParams := nimService.GetInferenceServiceParams()
+	inferenceServiceParams.OrchestratorType = string(r.GetOrchestratorType())
+
+	var modelPVC *appsv1alpha1.PersistentVolumeClaim
+	modelProfile := ""
+
+	// Select PVC for model store
+	if nimService.GetNIMCacheName() != "" {
+		// Fetch PVC for the associated NIMCache instance and mount it
+		var nimCachePVC *appsv1alpha1.PersistentVolumeClaim
+		nimCachePVC, err = r.getNIMCachePVC(ctx, nimService)
+		if err != nil {
+			logger.Error(err, "unable to obtain pvc backing the nimcache instance")
+			return ctrl.Result{}, err
+		}
+		logger.V(2).Info("obtained the backing pvc for nimcache instance", "pvc", nimCachePVC)
+		modelPVC = nimCachePVC
+
+		if profile := nimService.GetNIMCacheProfile(); profile != "" {
+			logger.Info("overriding model profile", "profile", profile)
+			modelProfile = profile
+		}
+	} else if nimService.Spec.Storage.PVC.Create != nil && *nimService.Spec.Storage.PVC.Create {
+		// Create a new PVC
+		modelPVC, err = r.reconcilePVC(ctx, nimService)
+		if err != nil {
+			logger.Error(err, "unable to create pvc")
+			return ctrl.Result{}, err
+		}
+	} else if nimService.Spec.Storage.PVC.Name != "" {
+		// Use an existing PVC
+		modelPVC = &nimService.Spec.Storage.PVC
+	} else {
+		err = fmt.Errorf("neither external PVC name or NIMCache volume is provided")
+		logger.Error(err, "failed to determine PVC for model-store")
+		return ctrl.Result{}, err
+	}
+	// Setup volume mounts with model store
+	servingRuntimeParams.Volumes = nimService.GetVolumes(*modelPVC)
+	servingRuntimeParams.VolumeMounts = nimService.GetVolumeMounts(*modelPVC)
+
+	// Setup env for explicit override profile is specified
+	if modelProfile != "" {
+		profileEnv := corev1.EnvVar{
+			Name:  "NIM_MODEL_PROFILE",
+			Value: modelProfile,
+		}
+		servingRuntimeParams.Env = append(servingRuntimeParams.Env, profileEnv)
+
+		// Retrieve and set profile details from NIMCache
+		var profile *appsv1alpha1.NIMProfile
+		profile, err = r.getNIMCacheProfile(ctx, nimService, modelProfile)
+		if err != nil {
+			logger.Error(err, "Failed to get cached NIM profile")
+			return ctrl.Result{}, err
+		}
+
+		// Auto assign GPU resources in case of the optimized profile
+		if profile != nil {
+			if inferenceServiceParams.Resources, err = assignGPUResources(ctx, nimService, profile, inferenceServiceParams.Resources); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	// Ensure the GPU resource requested by the NIM is provided by some node
+	if err = r.validateGPUResource(ctx, nimService, inferenceServiceParams.Resources); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync servingruntime
+	err = r.renderAndSyncResource(ctx, nimService, newUnstructured(ServingRuntimeGVK), func() (client.Object, error) {
+		return renderer.ServingRuntime(servingRuntimeParams)
+	}, "servingruntime", ReasonServingRuntimeFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync inferenceservice
+	err = r.renderAndSyncResource(ctx, nimService, newUnstructured(InferenceServiceGVK), func() (client.Object, error) {
+		return renderer.InferenceService(inferenceServiceParams)
+	}, "inferenceservice", ReasonInferenceServiceFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Wait for inferenceservice
+	msg, ready, err := r.isInferenceServiceReady(ctx, &namespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !ready {
+		// Update status as NotReady
+		err = r.updater.SetConditionsNotReady(ctx, nimService, conditions.NotReady, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.NotReady,
+			"NIMService %s not ready yet, msg: %s", nimService.Name, msg)
+	} else {
+		// Update status as ready
+		err = r.updater.SetConditionsReady(ctx, nimService, conditions.Ready, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.Ready,
+			"NIMService %s ready, msg: %s", nimService.Name, msg)
+	}
+
+	if err != nil {
+		logger.Error(err, "Unable to update status")
+		return ctrl.Result{}, err
+	}
+
+	// InferenceService is not an owned type watched by the controller, poll until it is ready
+	if !ready {
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *NIMServiceReconciler) renderAndSyncResource(ctx context.Context, nimService *appsv1alpha1.NIMService, obj client.Object, renderFunc func() (client.Object, error), conditionType string, reason string) error {
+	logger := log.FromContext(ctx)
+
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+
+	resource, err := renderFunc()
+	if err != nil {
+		logger.Error(err, "failed to render", conditionType, namespacedName)
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, reason, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
+
+	// Check if the resource is nil
+	if resource == nil || reflect.ValueOf(resource).IsNil() {
+		logger.V(2).Info("rendered nil resource")
+		return nil
+	}
+
+	if resource.GetName() == "" || resource.GetNamespace() == "" {
+		logger.V(2).Info("rendered un-initialized resource")
+		return nil
+	}
+
+	if err = controllerutil.SetControllerReference(nimService, resource, r.GetScheme()); err != nil {
+		logger.Error(err, "failed to set owner", conditionType, namespacedName)
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, reason, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
+
+	err = r.syncResource(ctx, obj, resource, namespacedName)
+	if err != nil {
+		logger.Error(err, "failed to sync", conditionType, namespacedName)
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, reason, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
 	return nil
 }
+
+// isInferenceServiceReady checks if the InferenceService is ready
+func (r *NIMServiceReconciler) isInferenceServiceReady(ctx context.Context, namespacedName *types.NamespacedName) (string, bool, error) {
+	isvc := newUnstructured(InferenceServiceGVK)
+	err := r.Get(ctx, client.ObjectKey{Name: namespacedName.Name, Namespace: namespacedName.Namespace}, isvc)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	statusConditions, _, err := unstructured.NestedSlice(isvc.Object, "status", "conditions")
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, c := range statusConditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["type"] != "Ready" {
+			continue
+		}
+		if cond["status"] == string(metav1.ConditionTrue) {
+			url, _, _ := unstructured.NestedString(isvc.Object, "status", "url")
+			return fmt.Sprintf("inferenceservice %q is ready at %q", isvc.GetName(), url), true, nil
+		}
+		reason, _ := cond["reason"].(string)
+		message, _ := cond["message"].(string)
+		return fmt.Sprintf("inferenceservice %q is not ready: %s %s", isvc.GetName(), reason, message), false, nil
+	}
+	return fmt.Sprintf("Waiting for inferenceservice %q to report status", isvc.GetName()), false, nil
+}
+
+func (r *NIMServiceReconciler) syncResource(ctx context.Context, obj client.Object, desired client.Object, namespacedName types.NamespacedName) error {
+	logger := log.FromContext(ctx)
+
+	err := r.Get(ctx, namespacedName, obj)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	if !utils.IsSpecChanged(obj, desired) {
+		logger.V(2).Info("Object spec has not changed, skipping update", "obj", obj)
+		return nil
+	}
+	logger.V(2).Info("Object spec has changed, updating")
+
+	if errors.IsNotFound(err) {
+		err = r.Create(ctx, desired)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Custom resources do not allow unconditional updates
+		desired.SetResourceVersion(obj.GetResourceVersion())
+		err = r.Update(ctx, desired)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanupResource deletes the given Kubernetes resource if it exists.
+// If the resource does not exist or an error occurs during deletion, the function returns nil or the error.
+func (r *NIMServiceReconciler) cleanupResource(ctx context.Context, obj client.Object, namespacedName types.NamespacedName) error {
+	logger := log.FromContext(ctx)
+
+	err := r.Get(ctx, namespacedName, obj)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	if errors.IsNotFound(err) {
+		return nil
+	}
+
+	err = r.Delete(ctx, obj)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	logger.V(2).Info("NIM Service object changed, deleting ", "obj", obj)
+	return nil
+}
+
+// getNIMCachePVC returns PVC backing the NIM cache instance
+func (r *NIMServiceReconciler) getNIMCachePVC(ctx context.Context, nimService *appsv1alpha1.NIMService) (*appsv1alpha1.PersistentVolumeClaim, error) {
+	nimCache, err := r.getNIMCache(ctx, nimService)
+	if err != nil || nimCache == nil {
+		return nil, err
+	}
+
+	if nimCache.Status.PVC == "" {
+		return nil, fmt.Errorf("missing PVC for the nimcache instance %s, nimservice %s", nimCache.GetName(), nimService.GetName())
+	}
+
+	if nimCache.Spec.Storage.PVC.Name == "" {
+		nimCache.Spec.Storage.PVC.Name = nimCache.Status.PVC
+	}
+	// Get the underlying PVC for the NIMCache instance
+	return &nimCache.Spec.Storage.PVC, nil
+}
+
+// getNIMCacheProfile returns model profile info from the NIM cache instance
+func (r *NIMServiceReconciler) getNIMCacheProfile(ctx context.Context, nimService *appsv1alpha1.NIMService, profile string) (*appsv1alpha1.NIMProfile, error) {
+	nimCache, err := r.getNIMCache(ctx, nimService)
+	if err != nil || nimCache == nil {
+		return nil, err
+	}
+
+	for _, cachedProfile := range nimCache.Status.Profiles {
+		if cachedProfile.Name == profile {
+			return &cachedProfile, nil
+		}
+	}
+
+	// If the specified profile is not cached, return nil
+	return nil, nil
+}
+
+// getNIMCache returns the ready NIMCache instance referenced by the NIMService
+func (r *NIMServiceReconciler) getNIMCache(ctx context.Context, nimService *appsv1alpha1.NIMService) (*appsv1alpha1.NIMCache, error) {
+	logger := log.FromContext(ctx)
+
+	if nimService.GetNIMCacheName() == "" {
+		// NIM cache is not used
+		return nil, nil
+	}
+
+	// Lookup NIMCache instance in the same namespace as the NIMService instance
+	nimCache := &appsv1alpha1.NIMCache{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetNIMCacheName(), Namespace: nimService.Namespace}, nimCache); err != nil {
+		logger.Error(err, "unable to fetch nimcache", "nimcache", nimService.GetNIMCacheName(), "nimservice", nimService.Name)
+		return nil, err
+	}
+
+	// Get the status of NIMCache
+	if nimCache.Status.State != appsv1alpha1.NimCacheStatusReady {
+		return nil, fmt.Errorf("nimcache %s is not ready, nimservice %s", nimCache.GetName(), nimService.GetName())
+	}
+	if nimService.IsNIMCacheVerificationRequired() && !nimCache.IsVerified() {
+		return nil, fmt.Errorf("nimcache %s is not verified, nimservice %s", nimCache.GetName(), nimService.GetName())
+	}
+	return nimCache, nil
+}
+
+func (r *NIMServiceReconciler) reconcilePVC(ctx context.Context, nimService *appsv1alpha1.NIMService) (*appsv1alpha1.PersistentVolumeClaim, error) {
+	logger := r.GetLogger()
+	pvcName := nimService.GetPVCName(nimService.Spec.Storage.PVC)
+	pvcNamespacedName := types.NamespacedName{Name: pvcName, Namespace: nimService.GetNamespace()}
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Get(ctx, pvcNamespacedName, pvc)
+	if err != nil && client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+
+	// If PVC does not exist, create a new one
+	if err != nil {
+		pvc, err = shared.ConstructPVC(nimService.Spec.Storage.PVC, metav1.ObjectMeta{Name: pvcName, Namespace: nimService.GetNamespace()})
+		if err != nil {
+			logger.Error(err, "Failed to construct pvc", "name", pvcName)
+			return nil, err
+		}
+		if err := controllerutil.SetControllerReference(nimService, pvc, r.GetScheme()); err != nil {
+			return nil, err
+		}
+		err = r.Create(ctx, pvc)
+		if err != nil {
+			logger.Error(err, "Failed to create pvc", "name", pvc.Name)
+			return nil, err
+		}
+		logger.Info("Created PVC for NIM Service", "pvc", pvcName)
+	}
+
+	// If explicit name is not provided in the spec, update it with the one created
+	if nimService.Spec.Storage.PVC.Name == "" {
+		nimService.Spec.Storage.PVC.Name = pvc.Name
+	}
+
+	return &nimService.Spec.Storage.PVC, nil
+}
+
+// validateGPUResource checks that the GPU resource requested by the NIMService is allocatable on at least one node
+func (r *NIMServiceReconciler) validateGPUResource(ctx context.Context, nimService *appsv1alpha1.NIMService, resources *corev1.ResourceRequirements) error {
+	gpuResourceName := nimService.GetGPUResourceName()
+	if resources == nil {
+		return nil
+	}
+	_, gpuRequested := resources.Requests[gpuResourceName]
+	_, gpuLimit := resources.Limits[gpuResourceName]
+	if !gpuRequested && !gpuLimit {
+		return nil
+	}
+
+	allocatable, err := k8sutil.IsResourceAllocatable(ctx, r.GetClient(), gpuResourceName)
+	if err != nil {
+		return err
+	}
+	if !allocatable {
+		err = fmt.Errorf("GPU resource %s is not allocatable on any node", gpuResourceName)
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonGPUResourceUnavailable, err.Error())
+		if statusError != nil {
+			log.FromContext(ctx).Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
+	return nil
+}
+
+// assignGPUResources returns the predictor resources with GPUs assigned based on the tensor parallelism
+// of the given profile, retaining any user-specified GPU resources.
+func assignGPUResources(ctx context.Context, nimService *appsv1alpha1.NIMService, profile *appsv1alpha1.NIMProfile, resources *corev1.ResourceRequirements) (*corev1.ResourceRequirements, error) {
+	logger := log.FromContext(ctx)
+
+	gpuResourceName := nimService.GetGPUResourceName()
+
+	if resources != nil {
+		if _, gpuRequested := resources.Requests[gpuResourceName]; gpuRequested {
+			logger.V(2).Info("User has provided GPU resource requests, skipping auto-assignment", "gpuResource", gpuResourceName)
+			return resources, nil
+		}
+		if _, gpuLimit := resources.Limits[gpuResourceName]; gpuLimit {
+			logger.V(2).Info("User has provided GPU resource limits, skipping auto-assignment", "gpuResource", gpuResourceName)
+			return resources, nil
+		}
+		resources = resources.DeepCopy()
+	} else {
+		resources = &corev1.ResourceRequirements{}
+	}
+	if resources.Requests == nil {
+		resources.Requests = corev1.ResourceList{}
+	}
+	if resources.Limits == nil {
+		resources.Limits = corev1.ResourceList{}
+	}
+
+	// Assign GPU resources based on tensorParallelism, or default to 1 GPU
+	gpuQuantity := apiResource.MustParse("1")
+	for _, key := range []string{"tensorParallelism", "tp"} {
+		if value, exists := profile.Config[key]; exists {
+			var err error
+			gpuQuantity, err = apiResource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse tensorParallelism: %w", err)
+			}
+			break
+		}
+	}
+
+	// MIG devices cannot be combined for tensor parallelism, only single-GPU profiles fit a MIG device
+	if nimService.IsMIGResource() && gpuQuantity.Value() > 1 {
+		return nil, fmt.Errorf("profile %s requires %s GPUs, MIG resource %s provides a single device per pod",
+			profile.Name, gpuQuantity.String(), gpuResourceName)
+	}
+
+	resources.Requests[gpuResourceName] = gpuQuantity
+	resources.Limits[gpuResourceName] = gpuQuantity
+	return resources, nil
+}
+
+// newUnstructured returns an empty unstructured object of the given kind
+func newUnstructured(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
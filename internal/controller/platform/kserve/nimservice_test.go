@@ -0,0 +1,271 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kserve
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("NIMServiceReconciler for the KServe platform", func() {
+	var (
+		client     client.Client
+		reconciler *NIMServiceReconciler
+		scheme     *runtime.Scheme
+		nimService *appsv1alpha1.NIMService
+		nimCache   *appsv1alpha1.NIMCache
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(appsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		client = fake.NewClientBuilder().WithScheme(scheme).
+			WithStatusSubresource(&appsv1alpha1.NIMService{}).
+			WithStatusSubresource(&appsv1alpha1.NIMCache{}).
+			Build()
+		cwd, err := os.Getwd()
+		if err != nil {
+			panic(err)
+		}
+
+		reconciler = &NIMServiceReconciler{
+			Client:   client,
+			scheme:   scheme,
+			updater:  conditions.NewUpdater(client),
+			renderer: render.NewRenderer(path.Join(strings.TrimSuffix(cwd, "internal/controller/platform/kserve"), "manifests")),
+			recorder: record.NewFakeRecorder(1000),
+		}
+
+		nimService = &appsv1alpha1.NIMService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-nimservice",
+				Namespace: "default",
+			},
+			Spec: appsv1alpha1.NIMServiceSpec{
+				Image:      appsv1alpha1.Image{Repository: "nvcr.io/nvidia/nim-llm", PullPolicy: "IfNotPresent", Tag: "v0.1.0", PullSecrets: []string{"ngc-secret"}},
+				AuthSecret: "ngc-api-secret",
+				Storage: appsv1alpha1.NIMServiceStorage{
+					NIMCache: appsv1alpha1.NIMCacheVolSpec{
+						Name:    "test-nimcache",
+						Profile: "test-profile",
+					},
+				},
+				Env: []corev1.EnvVar{{Name: "custom-env", Value: "custom-value"}},
+				Resources: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU: apiResource.MustParse("500m"),
+					},
+				},
+				NodeSelector: map[string]string{"disktype": "ssd"},
+				Expose: appsv1alpha1.NIMServiceExpose{Expose: appsv1alpha1.Expose{
+					Service: appsv1alpha1.Service{Type: corev1.ServiceTypeClusterIP, Port: 8000},
+				}},
+				Scale: appsv1alpha1.NIMServiceAutoscaling{Autoscaling: appsv1alpha1.Autoscaling{
+					Enabled: ptr.To[bool](true),
+					HPA: appsv1alpha1.HorizontalPodAutoscalerSpec{
+						MinReplicas: ptr.To[int32](2),
+						MaxReplicas: 5,
+						Metrics: []autoscalingv2.MetricSpec{
+							{
+								Type: autoscalingv2.ResourceMetricSourceType,
+								Resource: &autoscalingv2.ResourceMetricSource{
+									Name: corev1.ResourceCPU,
+									Target: autoscalingv2.MetricTarget{
+										Type:               autoscalingv2.UtilizationMetricType,
+										AverageUtilization: ptr.To[int32](75),
+									},
+								},
+							},
+						},
+					},
+				}},
+				Replicas: 1,
+			},
+		}
+
+		nimCache = &appsv1alpha1.NIMCache{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-nimcache",
+				Namespace: "default",
+			},
+			Spec: appsv1alpha1.NIMCacheSpec{
+				Storage: appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{SubPath: "subPath"}},
+			},
+		}
+		Expect(client.Create(context.TODO(), nimCache)).To(Succeed())
+		nimCache.Status = appsv1alpha1.NIMCacheStatus{
+			State: appsv1alpha1.NimCacheStatusReady,
+			PVC:   "test-nimcache-pvc",
+			Profiles: []appsv1alpha1.NIMProfile{{
+				Name:   "test-profile",
+				Config: map[string]string{"tp": "2"},
+			}},
+		}
+		Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+		Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+		Expect(client.Create(context.TODO(), &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "gpu-node"},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{"nvidia.com/gpu": apiResource.MustParse("8")},
+			},
+		})).To(Succeed())
+	})
+
+	Describe("Reconcile", func() {
+		It("should create the ServingRuntime and InferenceService for the NIMService", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{RequeueAfter: 30 * time.Second}))
+
+			serviceAccount := &corev1.ServiceAccount{}
+			Expect(client.Get(context.TODO(), namespacedName, serviceAccount)).To(Succeed())
+
+			servingRuntime := newUnstructured(ServingRuntimeGVK)
+			Expect(client.Get(context.TODO(), namespacedName, servingRuntime)).To(Succeed())
+			Expect(servingRuntime.GetOwnerReferences()).To(HaveLen(1))
+			containers, _, _ := unstructured.NestedSlice(servingRuntime.Object, "spec", "containers")
+			Expect(containers).To(HaveLen(1))
+			container := containers[0].(map[string]interface{})
+			Expect(container["name"]).To(Equal(appsv1alpha1.KServeContainerName))
+			Expect(container["image"]).To(Equal(nimService.GetImage()))
+			Expect(container["env"]).To(ContainElement(map[string]interface{}{"name": "NIM_MODEL_PROFILE", "value": "test-profile"}))
+			Expect(container["env"]).To(ContainElement(map[string]interface{}{"name": "custom-env", "value": "custom-value"}))
+			volumes, _, _ := unstructured.NestedSlice(servingRuntime.Object, "spec", "volumes")
+			Expect(volumes).To(ContainElement(HaveKeyWithValue("persistentVolumeClaim", map[string]interface{}{"claimName": "test-nimcache-pvc"})))
+
+			isvc := newUnstructured(InferenceServiceGVK)
+			Expect(client.Get(context.TODO(), namespacedName, isvc)).To(Succeed())
+			runtimeName, _, _ := unstructured.NestedString(isvc.Object, "spec", "predictor", "model", "runtime")
+			Expect(runtimeName).To(Equal(nimService.Name))
+			minReplicas, _, _ := unstructured.NestedInt64(isvc.Object, "spec", "predictor", "minReplicas")
+			Expect(minReplicas).To(Equal(int64(2)))
+			maxReplicas, _, _ := unstructured.NestedInt64(isvc.Object, "spec", "predictor", "maxReplicas")
+			Expect(maxReplicas).To(Equal(int64(5)))
+			scaleTarget, _, _ := unstructured.NestedInt64(isvc.Object, "spec", "predictor", "scaleTarget")
+			Expect(scaleTarget).To(Equal(int64(75)))
+			gpus, _, _ := unstructured.NestedString(isvc.Object, "spec", "predictor", "model", "resources", "limits", "nvidia.com/gpu")
+			Expect(gpus).To(Equal("2"))
+
+			// InferenceService has no status yet
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Ready)).To(BeFalse())
+		})
+
+		It("should assign the configured GPU resource and reject resources not allocatable on any node", func() {
+			nimService.Spec.GPUResourceName = "nvidia.com/gpu.shared"
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).To(MatchError(ContainSubstring("GPU resource nvidia.com/gpu.shared is not allocatable on any node")))
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Failed)).To(BeTrue())
+
+			Expect(client.Create(context.TODO(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "time-sliced-node"},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{"nvidia.com/gpu.shared": apiResource.MustParse("16")},
+				},
+			})).To(Succeed())
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			isvc := newUnstructured(InferenceServiceGVK)
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, isvc)).To(Succeed())
+			gpus, _, _ := unstructured.NestedString(isvc.Object, "spec", "predictor", "model", "resources", "limits", "nvidia.com/gpu.shared")
+			Expect(gpus).To(Equal("2"))
+		})
+
+		It("should reject multi-GPU profiles on MIG devices", func() {
+			nimService.Spec.GPUResourceName = "nvidia.com/mig-3g.40gb"
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).To(MatchError(ContainSubstring("MIG resource nvidia.com/mig-3g.40gb provides a single device per pod")))
+		})
+
+		It("should mark the NIMService ready when the InferenceService is ready", func() {
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			isvc := newUnstructured(InferenceServiceGVK)
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, isvc)).To(Succeed())
+			Expect(unstructured.SetNestedSlice(isvc.Object, []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			}, "status", "conditions")).To(Succeed())
+			Expect(unstructured.SetNestedField(isvc.Object, "http://test-nimservice.default.example.com", "status", "url")).To(Succeed())
+			Expect(client.Update(context.TODO(), isvc)).To(Succeed())
+
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(nimService.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusReady))
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Ready)).To(BeTrue())
+		})
+
+		It("should report the InferenceService failure reason when not ready", func() {
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			isvc := newUnstructured(InferenceServiceGVK)
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, isvc)).To(Succeed())
+			Expect(unstructured.SetNestedSlice(isvc.Object, []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "RevisionFailed", "message": "image pull failed"},
+			}, "status", "conditions")).To(Succeed())
+			Expect(client.Update(context.TODO(), isvc)).To(Succeed())
+
+			msg, ready, err := reconciler.isInferenceServiceReady(context.TODO(), &types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ready).To(BeFalse())
+			Expect(msg).To(ContainSubstring("image pull failed"))
+		})
+
+		It("should delete the InferenceService and ServingRuntime on cleanup", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.cleanupNIMService(context.TODO(), nimService)).To(Succeed())
+
+			err = client.Get(context.TODO(), namespacedName, newUnstructured(InferenceServiceGVK))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = client.Get(context.TODO(), namespacedName, newUnstructured(ServingRuntimeGVK))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,116 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"fmt"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcileResourceClaims allocates the NIMService GPUs with a DRA resource claim.
+//
+// A ResourceClaimTemplate is generated from the GPU count and product of the model profile unless an existing
+// template is referenced, and the pod and NIM container are wired to claim the devices through it.
+func (r *NIMServiceReconciler) reconcileResourceClaims(ctx context.Context, nimService *appsv1alpha1.NIMService, profile *appsv1alpha1.NIMProfile, deploymentParams *rendertypes.DeploymentParams) error {
+	generatedName := types.NamespacedName{Name: fmt.Sprintf("%s-gpu", nimService.GetName()), Namespace: nimService.GetNamespace()}
+
+	if !nimService.IsDRAEnabled() || nimService.Spec.DRA.ResourceClaimTemplateName != "" {
+		// Remove the generated template in case DRA was disabled or an existing template is referenced
+		if err := r.cleanupResource(ctx, &resourcev1alpha3.ResourceClaimTemplate{}, generatedName); err != nil && !meta.IsNoMatchError(err) {
+			return err
+		}
+	} else {
+		count := int64(1)
+		productName := ""
+		if profile != nil {
+			tensorParallelism, err := r.getTensorParallelismByProfile(ctx, profile)
+			if err != nil {
+				return err
+			}
+			if tensorParallelism != "" {
+				tp, err := apiResource.ParseQuantity(tensorParallelism)
+				if err != nil {
+					return fmt.Errorf("failed to parse tensorParallelism: %w", err)
+				}
+				count = tp.Value()
+			}
+			productName = profile.Config[profileGPUKey]
+		}
+
+		template := shared.ConstructResourceClaimTemplate(*nimService.Spec.DRA, count, productName, metav1.ObjectMeta{
+			Name:      generatedName.Name,
+			Namespace: generatedName.Namespace,
+			Labels:    nimService.GetServiceLabels(),
+		})
+		if err := r.syncResourceClaimTemplate(ctx, nimService, template); err != nil {
+			return err
+		}
+	}
+
+	if !nimService.IsDRAEnabled() {
+		return nil
+	}
+
+	resources := &corev1.ResourceRequirements{}
+	if deploymentParams.Resources != nil {
+		resources = deploymentParams.Resources.DeepCopy()
+	}
+	shared.AddResourceClaim(resources)
+	deploymentParams.Resources = resources
+	deploymentParams.ResourceClaims = shared.ResourceClaimsForTemplate(nimService.GetResourceClaimTemplateName())
+	return nil
+}
+
+// syncResourceClaimTemplate creates the ResourceClaimTemplate, or replaces it as its spec is immutable
+func (r *NIMServiceReconciler) syncResourceClaimTemplate(ctx context.Context, nimService *appsv1alpha1.NIMService, desired *resourcev1alpha3.ResourceClaimTemplate) error {
+	logger := log.FromContext(ctx)
+
+	if err := controllerutil.SetControllerReference(nimService, desired, r.GetScheme()); err != nil {
+		return err
+	}
+
+	current := &resourcev1alpha3.ResourceClaimTemplate{}
+	err := r.Get(ctx, types.NamespacedName{Name: desired.GetName(), Namespace: desired.GetNamespace()}, current)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if !utils.IsSpecChanged(current, desired) {
+		return nil
+	}
+
+	if err == nil {
+		logger.Info("Replacing resource claim template", "name", desired.GetName())
+		if err := r.Delete(ctx, current); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return r.Create(ctx, desired)
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"fmt"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// LoRAAdapterLabelKey is the label identifying the LoRA adapter downloaded by a job
+	LoRAAdapterLabelKey = "nvidia.com/lora-adapter"
+	// LoRAAdapterContainerName is the name of the container downloading a LoRA adapter
+	LoRAAdapterContainerName = "lora-download"
+)
+
+// getLoRAJobLabels returns the labels for the LoRA adapter download jobs of the NIMService
+func getLoRAJobLabels(nimService *appsv1alpha1.NIMService) map[string]string {
+	// Instance labels are not set to keep the job pods out of the NIMService service selectors
+	return map[string]string{
+		"app.kubernetes.io/name":       nimService.GetName(),
+		"app.kubernetes.io/component":  "lora-adapter",
+		"app.kubernetes.io/managed-by": "k8s-nim-operator",
+	}
+}
+
+// reconcileLoRAAdapters downloads the LoRA adapters of the NIMService and reports their state in the status
+func (r *NIMServiceReconciler) reconcileLoRAAdapters(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	logger := log.FromContext(ctx)
+
+	// Remove download jobs of adapters no longer requested
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, client.InNamespace(nimService.GetNamespace()), client.MatchingLabels(getLoRAJobLabels(nimService))); err != nil {
+		return err
+	}
+	requested := map[string]bool{}
+	if nimService.IsLoRAEnabled() {
+		for _, adapter := range nimService.Spec.LoRA.Adapters {
+			requested[nimService.GetLoRAAdapterJobName(adapter.Name)] = adapter.PVC == nil
+		}
+	}
+	for i := range jobs.Items {
+		if !requested[jobs.Items[i].Name] {
+			logger.Info("Deleting download job of removed LoRA adapter", "job", jobs.Items[i].Name)
+			if err := r.Delete(ctx, &jobs.Items[i], client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+
+	if !nimService.IsLoRAEnabled() {
+		nimService.Status.LoRAAdapters = nil
+		return nil
+	}
+
+	if nimService.IsLoRAStorageRequired() {
+		if err := r.reconcileLoRAPVC(ctx, nimService); err != nil {
+			return err
+		}
+	}
+
+	statuses := make([]appsv1alpha1.LoRAAdapterStatus, 0, len(nimService.Spec.LoRA.Adapters))
+	for _, adapter := range nimService.Spec.LoRA.Adapters {
+		status, err := r.reconcileLoRAAdapter(ctx, nimService, adapter)
+		if err != nil {
+			logger.Error(err, "failed to reconcile LoRA adapter", "adapter", adapter.Name)
+			return err
+		}
+		statuses = append(statuses, status)
+	}
+	nimService.Status.LoRAAdapters = statuses
+	return nil
+}
+
+// reconcileLoRAPVC creates the PVC LoRA adapters are downloaded to when requested
+func (r *NIMServiceReconciler) reconcileLoRAPVC(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	pvcName := types.NamespacedName{Name: nimService.GetLoRAPVCName(), Namespace: nimService.GetNamespace()}
+	err := r.Get(ctx, pvcName, &corev1.PersistentVolumeClaim{})
+	if err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+
+	storage := nimService.Spec.LoRA.Storage
+	if storage.Create == nil || !*storage.Create {
+		return fmt.Errorf("LoRA adapter storage pvc %s doesn't exist and auto-creation is not enabled", pvcName.Name)
+	}
+	pvc, err := shared.ConstructPVC(storage, metav1.ObjectMeta{Name: pvcName.Name, Namespace: pvcName.Namespace})
+	if err != nil {
+		return err
+	}
+	if err := controllerutil.SetControllerReference(nimService, pvc, r.GetScheme()); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, pvc); err != nil {
+		return err
+	}
+	log.FromContext(ctx).Info("Created PVC for LoRA adapters", "pvc", pvcName.Name)
+	return nil
+}
+
+// reconcileLoRAAdapter makes the given adapter available to NIM and returns its state
+func (r *NIMServiceReconciler) reconcileLoRAAdapter(ctx context.Context, nimService *appsv1alpha1.NIMService, adapter appsv1alpha1.LoRAAdapter) (appsv1alpha1.LoRAAdapterStatus, error) {
+	status := appsv1alpha1.LoRAAdapterStatus{Name: adapter.Name}
+
+	// Adapters on existing PVCs are mounted in place
+	if adapter.PVC != nil {
+		err := r.Get(ctx, types.NamespacedName{Name: adapter.PVC.Name, Namespace: nimService.GetNamespace()}, &corev1.PersistentVolumeClaim{})
+		if errors.IsNotFound(err) {
+			status.State = appsv1alpha1.LoRAAdapterStatePending
+			status.Message = fmt.Sprintf("pvc %s not found", adapter.PVC.Name)
+			return status, nil
+		} else if err != nil {
+			return status, err
+		}
+		status.State = appsv1alpha1.LoRAAdapterStateReady
+		return status, nil
+	}
+
+	desired, err := constructLoRAJob(nimService, adapter)
+	if err != nil {
+		return status, err
+	}
+	// Job specs are immutable, record the adapter source to replace the job when it changes
+	desired.Annotations = map[string]string{utils.NvidiaAnnotationHashKey: utils.GetResourceHash(desired)}
+
+	job := &batchv1.Job{}
+	err = r.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, job)
+	if err != nil && !errors.IsNotFound(err) {
+		return status, err
+	}
+	if errors.IsNotFound(err) {
+		if err := controllerutil.SetControllerReference(nimService, desired, r.GetScheme()); err != nil {
+			return status, err
+		}
+		if err := r.Create(ctx, desired); err != nil {
+			return status, err
+		}
+		status.State = appsv1alpha1.LoRAAdapterStateDownloading
+		return status, nil
+	}
+	if job.Annotations[utils.NvidiaAnnotationHashKey] != desired.Annotations[utils.NvidiaAnnotationHashKey] {
+		// Source changed, the job is recreated once the previous one is deleted
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return status, err
+		}
+		status.State = appsv1alpha1.LoRAAdapterStatePending
+		status.Message = "adapter source changed, replacing the download job"
+		return status, nil
+	}
+
+	switch {
+	case job.Status.Succeeded > 0:
+		status.State = appsv1alpha1.LoRAAdapterStateReady
+	case isJobFailed(job):
+		status.State = appsv1alpha1.LoRAAdapterStateFailed
+		status.Message = fmt.Sprintf("download job %s failed", job.Name)
+	default:
+		status.State = appsv1alpha1.LoRAAdapterStateDownloading
+	}
+	return status, nil
+}
+
+// isJobFailed returns true if the given job has failed
+func isJobFailed(job *batchv1.Job) bool {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// constructLoRAJob returns the job downloading the given adapter to the LoRA adapter storage
+func constructLoRAJob(nimService *appsv1alpha1.NIMService, adapter appsv1alpha1.LoRAAdapter) (*batchv1.Job, error) {
+	adapterPath := fmt.Sprintf("%s/%s", appsv1alpha1.LoRAAdaptersPath, adapter.Name)
+	labels := getLoRAJobLabels(nimService)
+	podLabels := utils.MergeMaps(labels, map[string]string{LoRAAdapterLabelKey: adapter.Name})
+
+	container := corev1.Container{
+		Name: LoRAAdapterContainerName,
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "lora-store",
+				MountPath: appsv1alpha1.LoRAAdaptersPath,
+				SubPath:   nimService.Spec.LoRA.Storage.SubPath,
+			},
+			{
+				Name:      "download",
+				MountPath: "/download",
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To[bool](false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+			RunAsNonRoot: ptr.To[bool](true),
+			RunAsGroup:   nimService.GetGroupID(),
+			RunAsUser:    nimService.GetUserID(),
+		},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+
+	var pullSecret string
+	switch {
+	case adapter.NGC != nil:
+		authSecret := adapter.NGC.AuthSecret
+		if authSecret == "" {
+			authSecret = nimService.Spec.AuthSecret
+		}
+		container.Image = adapter.NGC.ModelPuller
+		container.Env = []corev1.EnvVar{
+			{
+				Name: "NGC_CLI_API_KEY",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: authSecret},
+						Key:                  "NGC_API_KEY",
+					},
+				},
+			},
+			{Name: "NGC_MODEL", Value: adapter.NGC.Model},
+			{Name: "ADAPTER_PATH", Value: adapterPath},
+		}
+		// Download to a scratch volume first so that NIM never loads a partially downloaded adapter
+		container.Command = []string{"sh", "-c", `set -e
+ngc registry model download-version "$NGC_MODEL" --dest /download
+rm -rf "$ADAPTER_PATH"
+mv /download/* "$ADAPTER_PATH"`}
+		pullSecret = adapter.NGC.PullSecret
+	case adapter.DataStore != nil:
+		if adapter.DataStore.ModelName == nil || adapter.DataStore.CheckpointName == nil {
+			return nil, fmt.Errorf("modelName and checkpointName must be provided for LoRA adapter %s", adapter.Name)
+		}
+		container.Image = adapter.DataStore.ModelPuller
+		container.EnvFrom = (&appsv1alpha1.NIMSource{DataStore: adapter.DataStore}).EnvFromSecrets()
+		container.Env = []corev1.EnvVar{
+			{Name: "ADAPTER_PATH", Value: adapterPath},
+		}
+		container.Command = []string{"sh", "-c", fmt.Sprintf(`set -e
+datastore-tools checkpoint download --model-name %q --checkpoint-name %q --path /download/adapter --end-point %q
+rm -rf "$ADAPTER_PATH"
+mv /download/adapter "$ADAPTER_PATH"`, *adapter.DataStore.ModelName, *adapter.DataStore.CheckpointName, adapter.DataStore.Endpoint)}
+		pullSecret = adapter.DataStore.PullSecret
+	default:
+		return nil, fmt.Errorf("no download source for LoRA adapter %s", adapter.Name)
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nimService.GetLoRAAdapterJobName(adapter.Name),
+			Namespace: nimService.GetNamespace(),
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: podLabels,
+					Annotations: map[string]string{
+						"sidecar.istio.io/inject": "false",
+					},
+				},
+				Spec: corev1.PodSpec{
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsUser:    nimService.GetUserID(),
+						FSGroup:      nimService.GetGroupID(),
+						RunAsNonRoot: ptr.To[bool](true),
+					},
+					Containers:    []corev1.Container{container},
+					RestartPolicy: corev1.RestartPolicyNever,
+					Volumes: []corev1.Volume{
+						{
+							Name: "lora-store",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: nimService.GetLoRAPVCName(),
+								},
+							},
+						},
+						{
+							Name: "download",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+					},
+					ServiceAccountName: nimService.GetServiceAccountName(),
+					Tolerations:        nimService.GetTolerations(),
+					NodeSelector:       nimService.GetNodeSelector(),
+				},
+			},
+			// Jobs are kept after completion as the record of downloaded adapters
+			BackoffLimit: ptr.To[int32](3),
+		},
+	}
+	if runtimeClassName := nimService.GetRuntimeClassName(); runtimeClassName != "" {
+		job.Spec.Template.Spec.RuntimeClassName = &runtimeClassName
+	}
+	if pullSecret != "" {
+		job.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: pullSecret}}
+	}
+	return job, nil
+}
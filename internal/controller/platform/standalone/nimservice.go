@@ -19,6 +19,9 @@ package standalone
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
@@ -33,19 +36,33 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// LeaderWorkerSetGVK is the GroupVersionKind of the LeaderWorkerSet used for multi-node deployments
+var LeaderWorkerSetGVK = schema.GroupVersionKind{Group: "leaderworkerset.x-k8s.io", Version: "v1", Kind: "LeaderWorkerSet"}
+
+// ScaledObjectGVK is the GroupVersionKind of the KEDA ScaledObject used for autoscaling
+var ScaledObjectGVK = schema.GroupVersionKind{Group: "keda.sh", Version: "v1alpha1", Kind: "ScaledObject"}
+
+// multiNodeRequeueInterval is the interval to poll the LeaderWorkerSet until all groups are ready
+const multiNodeRequeueInterval = 30 * time.Second
+
 // GetScheme returns the scheme of the reconciler
 func (r *NIMServiceReconciler) GetScheme() *runtime.Scheme {
 	return r.scheme
@@ -102,6 +119,38 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 
 	renderer := r.GetRenderer()
 
+	// Rollouts are only supported for single-node deployments
+	if nimService.IsRolloutEnabled() && (nimService.IsMultiNodeEnabled() || nimService.GetDeploymentKind() == appsv1alpha1.DeploymentKindStatefulSet) {
+		err = fmt.Errorf("rollout is only supported for single-node NIMService with deploymentKind %s", appsv1alpha1.DeploymentKindDeployment)
+		return ctrl.Result{}, err
+	}
+	// Canary traffic is split by the replicas of both revisions, the candidate never outnumbers the stable replicas
+	if nimService.IsRolloutEnabled() {
+		for _, step := range nimService.GetRolloutSteps() {
+			if step < 1 || step > 50 {
+				err = fmt.Errorf("rollout step %d is not between 1 and 50", step)
+				return ctrl.Result{}, err
+			}
+		}
+	}
+	// Suspension scales the deployment or statefulset, multi-node deployments and rollouts are not suspended
+	if (nimService.Spec.Suspend || nimService.IsScaleToZeroEnabled()) && (nimService.IsMultiNodeEnabled() || nimService.IsRolloutEnabled()) {
+		err = fmt.Errorf("suspend and scaleToZero are not supported for multi-node NIMService or with rollout")
+		return ctrl.Result{}, err
+	}
+	// Multi-node groups request GPUs per pod through the GPU resource
+	if nimService.IsDRAEnabled() && nimService.IsMultiNodeEnabled() {
+		err = fmt.Errorf("dra is not supported for multi-node NIMService")
+		return ctrl.Result{}, err
+	}
+
+	// Update the suspension state first, the service routes requests to the activator while the NIMService is idle
+	idleCheckAfter, err := r.reconcileSuspension(ctx, nimService)
+	if err != nil {
+		logger.Error(err, "unable to reconcile suspension")
+		return ctrl.Result{}, err
+	}
+
 	// Sync serviceaccount
 	err = r.renderAndSyncResource(ctx, nimService, &renderer, &corev1.ServiceAccount{}, func() (client.Object, error) {
 		return renderer.ServiceAccount(nimService.GetServiceAccountParams())
@@ -150,7 +199,7 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 	}
 
 	// Sync HPA
-	if nimService.IsAutoScalingEnabled() {
+	if nimService.IsAutoScalingEnabled() && !nimService.IsKEDAEnabled() && !nimService.IsSuspended() {
 		err = r.renderAndSyncResource(ctx, nimService, &renderer, &autoscalingv2.HorizontalPodAutoscaler{}, func() (client.Object, error) {
 			return renderer.HPA(nimService.GetHPAParams())
 		}, "hpa", conditions.ReasonHPAFailed)
@@ -158,13 +207,91 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 			return ctrl.Result{}, err
 		}
 	} else {
-		// If autoscaling is disabled, ensure the HPA is deleted
+		// If autoscaling is disabled or the NIMService is suspended, ensure the HPA is deleted
 		err = r.cleanupResource(ctx, &autoscalingv2.HorizontalPodAutoscaler{}, namespacedName)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 	}
 
+	// Add the rules serving the autoscaling presets to the prometheus-adapter configuration
+	if nimService.IsPrometheusAdapterEnabled() {
+		err = r.syncPrometheusAdapterRules(ctx, nimService)
+		if err != nil {
+			logger.Error(err, "failed to sync prometheus-adapter rules", "configmap", nimService.Spec.Scale.PrometheusAdapter.ConfigMap)
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync KEDA ScaledObject
+	if nimService.IsKEDAEnabled() && !nimService.IsSuspended() {
+		err = r.renderAndSyncResource(ctx, nimService, &renderer, newUnstructured(ScaledObjectGVK), func() (client.Object, error) {
+			return renderer.ScaledObject(nimService.GetScaledObjectParams())
+		}, "scaledobject", conditions.ReasonScaledObjectFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// If KEDA is disabled or the NIMService is suspended, ensure the ScaledObject is deleted
+		err = r.cleanupScaledObject(ctx, namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync PodDisruptionBudget
+	if nimService.IsPDBEnabled() {
+		err = r.renderAndSyncResource(ctx, nimService, &renderer, &policyv1.PodDisruptionBudget{}, func() (client.Object, error) {
+			return renderer.PodDisruptionBudget(nimService.GetPDBParams())
+		}, "pdb", conditions.ReasonPDBFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// If the disruption budget is disabled, ensure the PDB is deleted
+		err = r.cleanupResource(ctx, &policyv1.PodDisruptionBudget{}, namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync NetworkPolicy
+	if nimService.IsNetworkPolicyEnabled() {
+		err = r.renderAndSyncResource(ctx, nimService, &renderer, &networkingv1.NetworkPolicy{}, func() (client.Object, error) {
+			return renderer.NetworkPolicy(nimService.GetNetworkPolicyParams())
+		}, "networkpolicy", conditions.ReasonNetworkPolicyFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// If the network policy is disabled, ensure it is deleted
+		err = r.cleanupResource(ctx, &networkingv1.NetworkPolicy{}, namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync Gateway API routes
+	if nimService.IsGatewayRouteEnabled() {
+		var accepted bool
+		accepted, err = shared.ReconcileGatewayRoutes(ctx, r.GetClient(), renderer, nimService.GetGatewayRouteParams(), &nimService.Status.Conditions, func(obj client.Object, renderFunc func() (client.Object, error), conditionType string) error {
+			return r.renderAndSyncResource(ctx, nimService, &renderer, obj, renderFunc, conditionType, conditions.ReasonGatewayRouteFailed)
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		// Poll the routes until their Gateways report them as accepted
+		if !accepted && (idleCheckAfter == 0 || idleCheckAfter > shared.GatewayRouteRequeueInterval) {
+			idleCheckAfter = shared.GatewayRouteRequeueInterval
+		}
+	} else {
+		// If the routes are disabled, ensure they are deleted
+		err = shared.CleanupGatewayRoutes(ctx, r.GetClient(), namespacedName, &nimService.Status.Conditions)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Sync Service Monitor
 	if nimService.IsServiceMonitorEnabled() {
 		err = r.renderAndSyncResource(ctx, nimService, &renderer, &monitoringv1.ServiceMonitor{}, func() (client.Object, error) {
@@ -184,7 +311,8 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 	// Select PVC for model store
 	if nimService.GetNIMCacheName() != "" {
 		// Fetch PVC for the associated NIMCache instance and mount it
-		nimCachePVC, err := r.getNIMCachePVC(ctx, nimService)
+		var nimCachePVC *appsv1alpha1.PersistentVolumeClaim
+		nimCachePVC, err = r.getNIMCachePVC(ctx, nimService)
 		if err != nil {
 			logger.Error(err, "unable to obtain pvc backing the nimcache instance")
 			return ctrl.Result{}, err
@@ -192,7 +320,16 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 		logger.V(2).Info("obtained the backing pvc for nimcache instance", "pvc", nimCachePVC)
 		modelPVC = nimCachePVC
 
-		if profile := nimService.GetNIMCacheProfile(); profile != "" {
+		if nimService.IsAutoProfileSelectionEnabled() {
+			// Select the best cached profile for the GPUs available in the cluster
+			var selectedProfile *appsv1alpha1.NIMProfile
+			selectedProfile, err = r.selectNIMCacheProfile(ctx, nimService)
+			if err != nil {
+				logger.Error(err, "unable to select a cached model profile")
+				return ctrl.Result{}, err
+			}
+			modelProfile = selectedProfile.Name
+		} else if profile := nimService.GetNIMCacheProfile(); profile != "" {
 			logger.Info("overriding model profile", "profile", profile)
 			modelProfile = profile
 		}
@@ -211,11 +348,34 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 		logger.Error(err, "failed to determine PVC for model-store")
 		return ctrl.Result{}, err
 	}
+	if !nimService.IsAutoProfileSelectionEnabled() {
+		nimService.Status.Profile = nil
+	}
+
 	// Setup volume mounts with model store
 	deploymentParams.Volumes = nimService.GetVolumes(*modelPVC)
 	deploymentParams.VolumeMounts = nimService.GetVolumeMounts(*modelPVC)
 
+	// Setup LoRA adapters, NIM loads them from the adapters directory as they become available
+	err = r.reconcileLoRAAdapters(ctx, nimService)
+	if err != nil {
+		logger.Error(err, "unable to reconcile LoRA adapters")
+		return ctrl.Result{}, err
+	}
+	deploymentParams.Volumes = append(deploymentParams.Volumes, nimService.GetLoRAVolumes()...)
+	deploymentParams.VolumeMounts = append(deploymentParams.VolumeMounts, nimService.GetLoRAVolumeMounts()...)
+
+	// Setup TLS, the serving certificate is mounted from the TLS secret
+	err = r.reconcileTLS(ctx, nimService, renderer, deploymentParams)
+	if err != nil {
+		logger.Error(err, "unable to reconcile TLS")
+		return ctrl.Result{}, err
+	}
+	deploymentParams.Volumes = append(deploymentParams.Volumes, nimService.GetTLSVolumes()...)
+	deploymentParams.VolumeMounts = append(deploymentParams.VolumeMounts, nimService.GetTLSVolumeMounts()...)
+
 	// Setup env for explicit override profile is specified
+	var profile *appsv1alpha1.NIMProfile
 	if modelProfile != "" {
 		profileEnv := corev1.EnvVar{
 			Name:  "NIM_MODEL_PROFILE",
@@ -224,37 +384,148 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 		deploymentParams.Env = append(deploymentParams.Env, profileEnv)
 
 		// Retrieve and set profile details from NIMCache
-		var profile *appsv1alpha1.NIMProfile
 		profile, err = r.getNIMCacheProfile(ctx, nimService, modelProfile)
 		if err != nil {
 			logger.Error(err, "Failed to get cached NIM profile")
 			return ctrl.Result{}, err
 		}
 
-		// Auto assign GPU resources in case of the optimized profile
-		if profile != nil {
+		// Auto assign GPU resources in case of the optimized profile, DRA claims the GPUs instead
+		if profile != nil && !nimService.IsDRAEnabled() {
 			if err = r.assignGPUResources(ctx, nimService, profile, deploymentParams); err != nil {
 				return ctrl.Result{}, err
 			}
 		}
 
-		// TODO: assign GPU resources and node selector that is required for the selected profile
+		// Schedule pods only on nodes with the GPU the profile is optimized for
+		if profile != nil {
+			if err = r.assignNodeAffinity(ctx, nimService, profile, deploymentParams); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
 	}
 
-	// Sync deployment
-	err = r.renderAndSyncResource(ctx, nimService, &renderer, &appsv1.Deployment{}, func() (client.Object, error) {
-		return renderer.Deployment(deploymentParams)
-	}, "deployment", conditions.ReasonDeploymentFailed)
+	// Allocate GPUs with a DRA resource claim when enabled
+	err = r.reconcileResourceClaims(ctx, nimService, profile, deploymentParams)
 	if err != nil {
+		logger.Error(err, "Failed to reconcile resource claims")
 		return ctrl.Result{}, err
 	}
 
-	// Wait for deployment
-	msg, ready, err := r.isDeploymentReady(ctx, &namespacedName)
+	// Ensure the GPU resource requested by the NIM is provided by some node
+	err = r.validateGPUResource(ctx, nimService, deploymentParams.Resources)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	// Remove the candidate revision in case rollouts were disabled
+	if !nimService.IsRolloutEnabled() {
+		err = r.cleanupRollout(ctx, nimService)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if nimService.IsMultiNodeEnabled() {
+		var result ctrl.Result
+		result, err = r.reconcileLeaderWorkerSet(ctx, nimService, deploymentParams)
+		return withRequeueAfter(result, idleCheckAfter), err
+	}
+
+	// Remove the leaderworkerset in case of a switch from multi-node deployment
+	err = r.cleanupLeaderWorkerSet(ctx, namespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	nimService.Status.MultiNode = nil
+
+	var msg string
+	var ready bool
+	if nimService.GetDeploymentKind() == appsv1alpha1.DeploymentKindStatefulSet {
+		// Remove the deployment in case of a switch of the deployment kind
+		err = r.cleanupResource(ctx, &appsv1.Deployment{}, namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		statefulSetParams := nimService.GetStatefulSetParams()
+		statefulSetParams.OrchestratorType = deploymentParams.OrchestratorType
+		statefulSetParams.Annotations = deploymentParams.Annotations
+		statefulSetParams.Volumes = deploymentParams.Volumes
+		statefulSetParams.VolumeMounts = deploymentParams.VolumeMounts
+		statefulSetParams.Env = deploymentParams.Env
+		statefulSetParams.Resources = deploymentParams.Resources
+		statefulSetParams.ResourceClaims = deploymentParams.ResourceClaims
+		statefulSetParams.Affinity = deploymentParams.Affinity
+
+		// Setup per-replica volumes
+		statefulSetParams.VolumeClaimTemplates, err = getVolumeClaimTemplates(nimService)
+		if err != nil {
+			logger.Error(err, "unable to construct volume claim templates")
+			return ctrl.Result{}, err
+		}
+		for _, template := range nimService.Spec.VolumeClaimTemplates {
+			statefulSetParams.VolumeMounts = append(statefulSetParams.VolumeMounts, corev1.VolumeMount{
+				Name:      template.Name,
+				MountPath: template.MountPath,
+			})
+		}
+
+		// Sync statefulset
+		err = r.renderAndSyncResource(ctx, nimService, &renderer, &appsv1.StatefulSet{}, func() (client.Object, error) {
+			statefulSet, err := renderer.StatefulSet(statefulSetParams)
+			if err == nil && nimService.IsSuspended() {
+				statefulSet.Spec.Replicas = ptr.To[int32](0)
+			}
+			return statefulSet, err
+		}, "statefulset", conditions.ReasonStatefulSetFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Wait for statefulset
+		msg, ready, err = r.isStatefulSetReady(ctx, &namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// Remove the statefulset in case of a switch of the deployment kind
+		err = r.cleanupResource(ctx, &appsv1.StatefulSet{}, namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Roll out changes to the deployment progressively
+		if nimService.IsRolloutEnabled() {
+			var result ctrl.Result
+			result, err = r.reconcileRollout(ctx, nimService, deploymentParams)
+			return withRequeueAfter(result, idleCheckAfter), err
+		}
+
+		// Sync deployment
+		err = r.renderAndSyncResource(ctx, nimService, &renderer, &appsv1.Deployment{}, func() (client.Object, error) {
+			deployment, err := renderer.Deployment(deploymentParams)
+			if err == nil && nimService.IsSuspended() {
+				deployment.Spec.Replicas = ptr.To[int32](0)
+			}
+			return deployment, err
+		}, "deployment", conditions.ReasonDeploymentFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Wait for deployment
+		msg, ready, err = r.isDeploymentReady(ctx, &namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if nimService.IsSuspended() {
+		ready = false
+		msg = fmt.Sprintf("NIMService is suspended (%s)", nimService.Status.Suspension.Reason)
+	}
+
 	if !ready {
 		// Update status as NotReady
 		err = r.updater.SetConditionsNotReady(ctx, nimService, conditions.NotReady, msg)
@@ -272,9 +543,142 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 		return ctrl.Result{}, err
 	}
 
+	return ctrl.Result{RequeueAfter: idleCheckAfter}, nil
+}
+
+// withRequeueAfter returns the result requeued after the earliest of its own requeue and the given one
+func withRequeueAfter(result ctrl.Result, requeueAfter time.Duration) ctrl.Result {
+	if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = requeueAfter
+	}
+	return result
+}
+
+// reconcileLeaderWorkerSet deploys the NIMService as leader-worker groups spanning multiple nodes
+func (r *NIMServiceReconciler) reconcileLeaderWorkerSet(ctx context.Context, nimService *appsv1alpha1.NIMService, deploymentParams *rendertypes.DeploymentParams) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+	renderer := r.GetRenderer()
+
+	// Remove the single-node workloads in case of a switch to multi-node deployment
+	err := r.cleanupResource(ctx, &appsv1.Deployment{}, namespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	err = r.cleanupResource(ctx, &appsv1.StatefulSet{}, namespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Pods in all groups share the model store, profile and GPU resources resolved for the deployment
+	lwsParams := nimService.GetLeaderWorkerSetParams()
+	lwsParams.OrchestratorType = deploymentParams.OrchestratorType
+	lwsParams.Annotations = deploymentParams.Annotations
+	lwsParams.Volumes = deploymentParams.Volumes
+	lwsParams.VolumeMounts = deploymentParams.VolumeMounts
+	lwsParams.Resources = deploymentParams.Resources
+	lwsParams.Affinity = deploymentParams.Affinity
+	lwsParams.LeaderEnv = utils.MergeEnvVars(deploymentParams.Env, nimService.GetMultiNodeEnv(true))
+	lwsParams.WorkerEnv = utils.MergeEnvVars(deploymentParams.Env, nimService.GetMultiNodeEnv(false))
+
+	// Sync leaderworkerset
+	err = r.renderAndSyncResource(ctx, nimService, &renderer, newUnstructured(LeaderWorkerSetGVK), func() (client.Object, error) {
+		return renderer.LeaderWorkerSet(lwsParams)
+	}, "leaderworkerset", conditions.ReasonLeaderWorkerSetFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Wait for all leader-worker groups
+	msg, ready, err := r.isLeaderWorkerSetReady(ctx, nimService)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !ready {
+		// Update status as NotReady
+		err = r.updater.SetConditionsNotReady(ctx, nimService, conditions.NotReady, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.NotReady,
+			"NIMService %s not ready yet, msg: %s", nimService.Name, msg)
+	} else {
+		// Update status as ready
+		err = r.updater.SetConditionsReady(ctx, nimService, conditions.Ready, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.Ready,
+			"NIMService %s ready, msg: %s", nimService.Name, msg)
+	}
+
+	if err != nil {
+		logger.Error(err, "Unable to update status")
+		return ctrl.Result{}, err
+	}
+
+	// LeaderWorkerSet is not watched as its CRD is optional, poll until all groups are ready
+	if !ready {
+		return ctrl.Result{RequeueAfter: multiNodeRequeueInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
+// isLeaderWorkerSetReady checks if all groups of the LeaderWorkerSet are ready and records them in the NIMService status
+func (r *NIMServiceReconciler) isLeaderWorkerSetReady(ctx context.Context, nimService *appsv1alpha1.NIMService) (string, bool, error) {
+	lws := newUnstructured(LeaderWorkerSetGVK)
+	err := r.Get(ctx, client.ObjectKey{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}, lws)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	desired, found, _ := unstructured.NestedInt64(lws.Object, "spec", "replicas")
+	if !found {
+		desired = 1
+	}
+	groups, _, _ := unstructured.NestedInt64(lws.Object, "status", "replicas")
+	readyGroups, _, _ := unstructured.NestedInt64(lws.Object, "status", "readyReplicas")
+	updatedGroups, _, _ := unstructured.NestedInt64(lws.Object, "status", "updatedReplicas")
+	size, _, _ := unstructured.NestedInt64(lws.Object, "spec", "leaderWorkerTemplate", "size")
+
+	nimService.Status.MultiNode = &appsv1alpha1.MultiNodeStatus{
+		Groups:      int32(groups),
+		ReadyGroups: int32(readyGroups),
+		Size:        int32(size),
+	}
+	nimService.Status.AvailableReplicas = int32(readyGroups)
+
+	if updatedGroups < desired {
+		return fmt.Sprintf("Waiting for leaderworkerset %q rollout to finish: %d out of %d new groups have been updated...\n", lws.GetName(), updatedGroups, desired), false, nil
+	}
+	if readyGroups < desired {
+		return fmt.Sprintf("Waiting for leaderworkerset %q rollout to finish: %d of %d groups are ready...\n", lws.GetName(), readyGroups, desired), false, nil
+	}
+	return fmt.Sprintf("leaderworkerset %q successfully rolled out\n", lws.GetName()), true, nil
+}
+
+// cleanupLeaderWorkerSet deletes the LeaderWorkerSet if it exists, ignoring clusters without the LeaderWorkerSet CRD
+func (r *NIMServiceReconciler) cleanupLeaderWorkerSet(ctx context.Context, namespacedName types.NamespacedName) error {
+	err := r.cleanupResource(ctx, newUnstructured(LeaderWorkerSetGVK), namespacedName)
+	if err != nil && !meta.IsNoMatchError(err) {
+		return err
+	}
+	return nil
+}
+
+// cleanupScaledObject deletes the KEDA ScaledObject if it exists, ignoring clusters without KEDA
+func (r *NIMServiceReconciler) cleanupScaledObject(ctx context.Context, namespacedName types.NamespacedName) error {
+	err := r.cleanupResource(ctx, newUnstructured(ScaledObjectGVK), namespacedName)
+	if err != nil && !meta.IsNoMatchError(err) {
+		return err
+	}
+	return nil
+}
+
+func newUnstructured(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
 func (r *NIMServiceReconciler) renderAndSyncResource(ctx context.Context, nimService *appsv1alpha1.NIMService, renderer *render.Renderer, obj client.Object, renderFunc func() (client.Object, error), conditionType string, reason string) error {
 	logger := log.FromContext(ctx)
 
@@ -355,6 +759,36 @@ func (r *NIMServiceReconciler) isDeploymentReady(ctx context.Context, namespaced
 	return fmt.Sprintf("deployment %q successfully rolled out\n", deployment.Name), true, nil
 }
 
+// isStatefulSetReady checks if the StatefulSet is ready
+func (r *NIMServiceReconciler) isStatefulSetReady(ctx context.Context, namespacedName *types.NamespacedName) (string, bool, error) {
+	statefulSet := &appsv1.StatefulSet{}
+	err := r.Get(ctx, client.ObjectKey{Name: namespacedName.Name, Namespace: namespacedName.Namespace}, statefulSet)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return fmt.Sprintf("Waiting for statefulset %q spec update to be observed...\n", statefulSet.Name), false, nil
+	}
+	if statefulSet.Status.UpdatedReplicas < replicas {
+		return fmt.Sprintf("Waiting for statefulset %q rollout to finish: %d out of %d new pods have been updated...\n", statefulSet.Name, statefulSet.Status.UpdatedReplicas, replicas), false, nil
+	}
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return fmt.Sprintf("Waiting for statefulset %q rollout to finish: %d of %d updated pods are ready...\n", statefulSet.Name, statefulSet.Status.ReadyReplicas, replicas), false, nil
+	}
+	if statefulSet.Status.UpdateRevision != statefulSet.Status.CurrentRevision {
+		return fmt.Sprintf("Waiting for statefulset %q rolling update to complete...\n", statefulSet.Name), false, nil
+	}
+	return fmt.Sprintf("statefulset %q successfully rolled out\n", statefulSet.Name), true, nil
+}
+
 func getDeploymentCondition(status appsv1.DeploymentStatus, condType appsv1.DeploymentConditionType) *appsv1.DeploymentCondition {
 	for i := range status.Conditions {
 		c := status.Conditions[i]
@@ -385,6 +819,8 @@ func (r *NIMServiceReconciler) syncResource(ctx context.Context, obj client.Obje
 			return err
 		}
 	} else {
+		// Custom resources do not allow unconditional updates
+		desired.SetResourceVersion(obj.GetResourceVersion())
 		err = r.Update(ctx, desired)
 		if err != nil {
 			return err
@@ -424,6 +860,62 @@ func (r *NIMServiceReconciler) cleanupResource(ctx context.Context, obj client.O
 	return nil
 }
 
+// syncPrometheusAdapterRules adds the rules of the autoscaling presets to the configuration of prometheus-adapter,
+// which is shared with the other NIMServices and not owned by the NIMService
+func (r *NIMServiceReconciler) syncPrometheusAdapterRules(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	logger := log.FromContext(ctx)
+
+	adapter := nimService.Spec.Scale.PrometheusAdapter
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{Name: adapter.ConfigMap, Namespace: adapter.Namespace}, configMap); err != nil {
+		return err
+	}
+
+	key := nimService.GetPrometheusAdapterConfigKey()
+	config, changed, err := nimService.MergePrometheusAdapterRules(configMap.Data[key])
+	if err != nil {
+		return fmt.Errorf("failed to parse the prometheus-adapter configuration %s/%s: %w", adapter.Namespace, adapter.ConfigMap, err)
+	}
+	if !changed {
+		return nil
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[key] = config
+	if err := r.Update(ctx, configMap); err != nil {
+		return err
+	}
+	logger.Info("Added autoscaling preset rules to the prometheus-adapter configuration, restart prometheus-adapter to load them",
+		"configmap", adapter.ConfigMap, "namespace", adapter.Namespace)
+	return nil
+}
+
+// getVolumeClaimTemplates returns the PVC templates for the per-replica volumes of the NIMService statefulset
+func getVolumeClaimTemplates(nimService *appsv1alpha1.NIMService) ([]corev1.PersistentVolumeClaim, error) {
+	var templates []corev1.PersistentVolumeClaim
+	for _, template := range nimService.Spec.VolumeClaimTemplates {
+		pvc, err := shared.ConstructPVC(appsv1alpha1.PersistentVolumeClaim{
+			StorageClass:     template.StorageClass,
+			Size:             template.Size,
+			VolumeAccessMode: template.VolumeAccessMode,
+		}, metav1.ObjectMeta{Name: template.Name})
+		if err != nil {
+			return nil, err
+		}
+		// Use the default storage class unless explicitly provided
+		if template.StorageClass == "" {
+			pvc.Spec.StorageClassName = nil
+		}
+		if template.VolumeAccessMode == "" {
+			pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+		}
+		templates = append(templates, *pvc)
+	}
+	return templates, nil
+}
+
 // getNIMCachePVC returns PVC backing the NIM cache instance
 func (r *NIMServiceReconciler) getNIMCachePVC(ctx context.Context, nimService *appsv1alpha1.NIMService) (*appsv1alpha1.PersistentVolumeClaim, error) {
 	logger := log.FromContext(ctx)
@@ -442,6 +934,13 @@ func (r *NIMServiceReconciler) getNIMCachePVC(ctx context.Context, nimService *a
 	if nimCache.Status.State != appsv1alpha1.NimCacheStatusReady {
 		return nil, fmt.Errorf("nimcache %s is not ready, nimservice %s", nimCache.GetName(), nimService.GetName())
 	}
+	if nimService.IsNIMCacheVerificationRequired() && (!nimCache.IsVerificationEnabled() || !nimCache.HasChecksumsSource()) {
+		return nil, fmt.Errorf("nimcache %s cannot be verified, enable verification and set the checksums ConfigMap for DataStore sources, nimservice %s",
+			nimCache.GetName(), nimService.GetName())
+	}
+	if nimService.IsNIMCacheVerificationRequired() && !nimCache.IsVerified() {
+		return nil, fmt.Errorf("nimcache %s is not verified, nimservice %s", nimCache.GetName(), nimService.GetName())
+	}
 
 	if nimCache.Status.PVC == "" {
 		return nil, fmt.Errorf("missing PVC for the nimcache instance %s, nimservice %s", nimCache.GetName(), nimService.GetName())
@@ -551,6 +1050,82 @@ func (r *NIMServiceReconciler) getTensorParallelismByProfile(ctx context.Context
 	return tensorParallelism, nil
 }
 
+// getPipelineParallelismByProfile returns the value of pipeline parallelism parameter in the given NIM profile
+func (r *NIMServiceReconciler) getPipelineParallelismByProfile(ctx context.Context, profile *appsv1alpha1.NIMProfile) (string, error) {
+	// List of possible keys for pipeline parallelism
+	possibleKeys := []string{"pipelineParallelism", "pp"}
+
+	pipelineParallelism := ""
+
+	// Iterate through possible keys and return the first valid value
+	for _, key := range possibleKeys {
+		if value, exists := profile.Config[key]; exists {
+			pipelineParallelism = value
+			break
+		}
+	}
+
+	return pipelineParallelism, nil
+}
+
+// getMultiNodeGPUsPerPod returns the number of GPUs to assign to each pod of a multi-node group.
+//
+// Unless explicitly provided, the GPUs required by the profile (tensor x pipeline parallelism)
+// are spread evenly across the pods of the group.
+func (r *NIMServiceReconciler) getMultiNodeGPUsPerPod(ctx context.Context, nimService *appsv1alpha1.NIMService, profile *appsv1alpha1.NIMProfile, tensorParallelism apiResource.Quantity) (apiResource.Quantity, error) {
+	if gpusPerPod := nimService.Spec.MultiNode.GPUsPerPod; gpusPerPod > 0 {
+		return *apiResource.NewQuantity(int64(gpusPerPod), apiResource.DecimalSI), nil
+	}
+
+	pipelineParallelism, err := r.getPipelineParallelismByProfile(ctx, profile)
+	if err != nil {
+		return apiResource.Quantity{}, err
+	}
+	totalGPUs := tensorParallelism.Value()
+	if pipelineParallelism != "" {
+		pp, err := apiResource.ParseQuantity(pipelineParallelism)
+		if err != nil {
+			return apiResource.Quantity{}, fmt.Errorf("failed to parse pipelineParallelism: %w", err)
+		}
+		totalGPUs *= pp.Value()
+	}
+
+	size := int64(nimService.GetMultiNodeSize())
+	gpusPerPod := (totalGPUs + size - 1) / size
+	if gpusPerPod < 1 {
+		gpusPerPod = 1
+	}
+	return *apiResource.NewQuantity(gpusPerPod, apiResource.DecimalSI), nil
+}
+
+// validateGPUResource checks that the GPU resource requested by the NIMService is allocatable on at least one node
+func (r *NIMServiceReconciler) validateGPUResource(ctx context.Context, nimService *appsv1alpha1.NIMService, resources *corev1.ResourceRequirements) error {
+	gpuResourceName := nimService.GetGPUResourceName()
+	if resources == nil {
+		return nil
+	}
+	_, gpuRequested := resources.Requests[gpuResourceName]
+	_, gpuLimit := resources.Limits[gpuResourceName]
+	if !gpuRequested && !gpuLimit {
+		return nil
+	}
+
+	allocatable, err := k8sutil.IsResourceAllocatable(ctx, r.GetClient(), gpuResourceName)
+	if err != nil {
+		return err
+	}
+	if !allocatable {
+		err = fmt.Errorf("GPU resource %s is not allocatable on any node", gpuResourceName)
+		r.GetEventRecorder().Event(nimService, corev1.EventTypeWarning, conditions.ReasonGPUResourceUnavailable, err.Error())
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonGPUResourceUnavailable, err.Error())
+		if statusError != nil {
+			log.FromContext(ctx).Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
+	return nil
+}
+
 // assignGPUResources automatically assigns GPU resources to the NIMService based on the provided profile,
 // but retains any user-specified GPU resources if they are explicitly provided.
 //
@@ -561,8 +1136,7 @@ func (r *NIMServiceReconciler) getTensorParallelismByProfile(ctx context.Context
 func (r *NIMServiceReconciler) assignGPUResources(ctx context.Context, nimService *appsv1alpha1.NIMService, profile *appsv1alpha1.NIMProfile, deploymentParams *rendertypes.DeploymentParams) error {
 	logger := log.FromContext(ctx)
 
-	// TODO: Make the resource name configurable
-	const gpuResourceName = corev1.ResourceName("nvidia.com/gpu")
+	gpuResourceName := nimService.GetGPUResourceName()
 
 	// Check if the user has already provided a GPU resource quantity in the requests or limits
 	if deploymentParams.Resources != nil {
@@ -606,9 +1180,96 @@ func (r *NIMServiceReconciler) assignGPUResources(ctx context.Context, nimServic
 		logger.V(2).Info("tensorParallelism not found, assigning 1 GPU by default", "Profile", profile.Name)
 	}
 
+	// Spread the GPUs across the pods of each group for multi-node deployments
+	if nimService.IsMultiNodeEnabled() {
+		gpuQuantity, err = r.getMultiNodeGPUsPerPod(ctx, nimService, profile, gpuQuantity)
+		if err != nil {
+			return err
+		}
+		logger.V(2).Info("Auto-assigning GPU resources per pod for multi-node deployment", "size", nimService.GetMultiNodeSize(), "gpuQuantity", gpuQuantity.String())
+	}
+
+	// MIG devices cannot be combined for tensor parallelism, only single-GPU profiles fit a MIG device
+	if nimService.IsMIGResource() && gpuQuantity.Value() > 1 {
+		return fmt.Errorf("profile %s requires %s GPUs per pod, MIG resource %s provides a single device per pod",
+			profile.Name, gpuQuantity.String(), gpuResourceName)
+	}
+
 	// Assign the GPU quantity for both requests and limits
 	deploymentParams.Resources.Requests[gpuResourceName] = gpuQuantity
 	deploymentParams.Resources.Limits[gpuResourceName] = gpuQuantity
 
 	return nil
 }
+
+// assignNodeAffinity requires NIMService pods to be scheduled on nodes with the GPU product the profile is optimized for.
+//
+// The "gpu" tag of the profile is matched against the GPU product labels of the nodes in the cluster on name token
+// boundaries, and the matching products are added as a required node affinity term merged with any node affinity
+// already set. Profiles without a "gpu" tag are not restricted, and neither are clusters
+// without GPU product labels, as the product of their GPUs is unknown.
+func (r *NIMServiceReconciler) assignNodeAffinity(ctx context.Context, nimService *appsv1alpha1.NIMService, profile *appsv1alpha1.NIMProfile, deploymentParams *rendertypes.DeploymentParams) error {
+	logger := log.FromContext(ctx)
+
+	gpu := strings.TrimSpace(profile.Config[profileGPUKey])
+	if gpu == "" {
+		return nil
+	}
+
+	gpusByNode, err := k8sutil.GetNodeGPUProducts(ctx, r.GetClient())
+	if err != nil {
+		return err
+	}
+	if len(gpusByNode) == 0 {
+		logger.V(2).Info("No GPU product labels found on nodes, skipping node affinity for profile", "profile", profile.Name, "gpu", gpu)
+		return nil
+	}
+
+	var products []string
+	for _, product := range gpusByNode {
+		if matchGPUProduct(product, gpu) && !utils.ContainsElement(products, product) {
+			products = append(products, product)
+		}
+	}
+	if len(products) == 0 {
+		err = fmt.Errorf("no nodes with GPU %s required by profile %s", gpu, profile.Name)
+		r.GetEventRecorder().Event(nimService, corev1.EventTypeWarning, conditions.ReasonNoCompatibleGPUNodes, err.Error())
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonNoCompatibleGPUNodes, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
+	sort.Strings(products)
+
+	logger.V(2).Info("Assigning node affinity for profile", "profile", profile.Name, "products", products)
+	affinity := &corev1.Affinity{}
+	if deploymentParams.Affinity != nil {
+		affinity = deploymentParams.Affinity.DeepCopy()
+	}
+	affinity.NodeAffinity = mergeNodeAffinity(affinity.NodeAffinity, corev1.NodeSelectorRequirement{
+		Key:      k8sutil.GPUProductLabel,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   products,
+	})
+	deploymentParams.Affinity = affinity
+	return nil
+}
+
+// mergeNodeAffinity adds the requirement to every required node selector term of the given node affinity
+func mergeNodeAffinity(nodeAffinity *corev1.NodeAffinity, requirement corev1.NodeSelectorRequirement) *corev1.NodeAffinity {
+	merged := &corev1.NodeAffinity{}
+	if nodeAffinity != nil {
+		merged = nodeAffinity.DeepCopy()
+	}
+	if merged.RequiredDuringSchedulingIgnoredDuringExecution == nil || len(merged.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) == 0 {
+		merged.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{}},
+		}
+	}
+	terms := merged.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for i := range terms {
+		terms[i].MatchExpressions = append(terms[i].MatchExpressions, requirement)
+	}
+	return merged
+}
@@ -19,11 +19,17 @@ package standalone
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"path"
+	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"os"
 
@@ -31,19 +37,27 @@ import (
 	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
 	"github.com/NVIDIA/k8s-nim-operator/internal/render"
 	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
@@ -90,6 +104,9 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 		Expect(networkingv1.AddToScheme(scheme)).To(Succeed())
 		Expect(corev1.AddToScheme(scheme)).To(Succeed())
 		Expect(monitoringv1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+		Expect(resourcev1alpha3.AddToScheme(scheme)).To(Succeed())
+		Expect(policyv1.AddToScheme(scheme)).To(Succeed())
 
 		client = fake.NewClientBuilder().WithScheme(scheme).
 			WithStatusSubresource(&appsv1alpha1.NIMService{}).
@@ -183,7 +200,7 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 						Effect:   corev1.TaintEffectNoSchedule,
 					},
 				},
-				Expose: appsv1alpha1.Expose{
+				Expose: appsv1alpha1.NIMServiceExpose{Expose: appsv1alpha1.Expose{
 					Service: appsv1alpha1.Service{Type: corev1.ServiceTypeLoadBalancer, Port: 8123, Annotations: map[string]string{"annotation-key-specific": "service"}},
 					Ingress: appsv1alpha1.Ingress{
 						Enabled:     ptr.To[bool](true),
@@ -213,8 +230,8 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 							},
 						},
 					},
-				},
-				Scale: appsv1alpha1.Autoscaling{
+				}},
+				Scale: appsv1alpha1.NIMServiceAutoscaling{Autoscaling: appsv1alpha1.Autoscaling{
 					Enabled:     ptr.To[bool](true),
 					Annotations: map[string]string{"annotation-key-specific": "HPA"},
 					HPA: appsv1alpha1.HorizontalPodAutoscalerSpec{
@@ -239,7 +256,7 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 							},
 						},
 					},
-				},
+				}},
 				Metrics: appsv1alpha1.Metrics{
 					Enabled: &boolTrue,
 					ServiceMonitor: appsv1alpha1.ServiceMonitor{
@@ -537,6 +554,891 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 			Expect(errors.IsNotFound(err)).To(Equal(true))
 		})
 
+		It("should create a StatefulSet when the deployment kind is StatefulSet", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			nimService.Spec.DeploymentKind = appsv1alpha1.DeploymentKindStatefulSet
+			nimService.Spec.VolumeClaimTemplates = []appsv1alpha1.VolumeClaimTemplate{
+				{Name: "engine-cache", MountPath: "/engine-cache", Size: "10Gi"},
+			}
+			err := client.Create(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Deployment should not be created
+			err = client.Get(context.TODO(), namespacedName, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			statefulSet := &appsv1.StatefulSet{}
+			Expect(client.Get(context.TODO(), namespacedName, statefulSet)).To(Succeed())
+			Expect(statefulSet.Spec.ServiceName).To(Equal(nimService.GetName()))
+			Expect(statefulSet.Spec.VolumeClaimTemplates).To(HaveLen(1))
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Name).To(Equal("engine-cache"))
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Spec.StorageClassName).To(BeNil())
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Spec.AccessModes).To(Equal([]corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}))
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceStorage, resource.MustParse("10Gi")))
+			Expect(statefulSet.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "engine-cache", MountPath: "/engine-cache"}))
+
+			// HPA should scale the statefulset
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+			Expect(client.Get(context.TODO(), namespacedName, hpa)).To(Succeed())
+			Expect(hpa.Spec.ScaleTargetRef.Kind).To(Equal(appsv1alpha1.DeploymentKindStatefulSet))
+
+			// Switching back to a deployment removes the statefulset
+			nimService.Spec.DeploymentKind = appsv1alpha1.DeploymentKindDeployment
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), namespacedName, &appsv1.StatefulSet{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(client.Get(context.TODO(), namespacedName, &appsv1.Deployment{})).To(Succeed())
+		})
+
+		It("should create a LeaderWorkerSet for a multi-node NIMService", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			nimService.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{Size: 2}
+			err := client.Create(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{RequeueAfter: multiNodeRequeueInterval}))
+
+			// Deployment should not be created
+			err = client.Get(context.TODO(), namespacedName, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			lws := newUnstructured(LeaderWorkerSetGVK)
+			Expect(client.Get(context.TODO(), namespacedName, lws)).To(Succeed())
+			Expect(lws.GetOwnerReferences()).To(HaveLen(1))
+			size, _, _ := unstructured.NestedInt64(lws.Object, "spec", "leaderWorkerTemplate", "size")
+			Expect(size).To(Equal(int64(2)))
+			leaderContainers, _, _ := unstructured.NestedSlice(lws.Object, "spec", "leaderWorkerTemplate", "leaderTemplate", "spec", "containers")
+			Expect(leaderContainers).To(HaveLen(1))
+			Expect(leaderContainers[0].(map[string]interface{})["env"]).To(ContainElement(map[string]interface{}{"name": "NIM_LEADER_ROLE", "value": "1"}))
+			Expect(leaderContainers[0].(map[string]interface{})).To(HaveKey("readinessProbe"))
+			workerContainers, _, _ := unstructured.NestedSlice(lws.Object, "spec", "leaderWorkerTemplate", "workerTemplate", "spec", "containers")
+			Expect(workerContainers).To(HaveLen(1))
+			Expect(workerContainers[0].(map[string]interface{})["env"]).To(ContainElement(map[string]interface{}{"name": "NIM_LEADER_ROLE", "value": "0"}))
+			Expect(workerContainers[0].(map[string]interface{})).NotTo(HaveKey("readinessProbe"))
+			workerVolumes, _, _ := unstructured.NestedSlice(lws.Object, "spec", "leaderWorkerTemplate", "workerTemplate", "spec", "volumes")
+			Expect(workerVolumes).NotTo(BeEmpty())
+
+			// Service should only select the leader pods
+			service := &corev1.Service{}
+			Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+			Expect(service.Spec.Selector).To(HaveKeyWithValue(appsv1alpha1.LeaderWorkerSetWorkerIndexLabel, "0"))
+
+			// HPA should scale the leader-worker groups
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+			Expect(client.Get(context.TODO(), namespacedName, hpa)).To(Succeed())
+			Expect(hpa.Spec.ScaleTargetRef.Kind).To(Equal("LeaderWorkerSet"))
+			Expect(hpa.Spec.ScaleTargetRef.APIVersion).To(Equal("leaderworkerset.x-k8s.io/v1"))
+
+			Expect(nimService.Status.MultiNode).NotTo(BeNil())
+			Expect(nimService.Status.MultiNode.ReadyGroups).To(Equal(int32(0)))
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Ready)).To(BeFalse())
+
+			// Mark all groups ready
+			Expect(unstructured.SetNestedMap(lws.Object, map[string]interface{}{
+				"replicas":        int64(1),
+				"readyReplicas":   int64(1),
+				"updatedReplicas": int64(1),
+			}, "status")).To(Succeed())
+			Expect(client.Update(context.TODO(), lws)).To(Succeed())
+
+			result, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(nimService.Status.MultiNode).To(Equal(&appsv1alpha1.MultiNodeStatus{Groups: 1, ReadyGroups: 1, Size: 2}))
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Ready)).To(BeTrue())
+
+			// The routes are polled until accepted once the groups are ready
+			nimService.Spec.Expose.GatewayRoute = appsv1alpha1.GatewayRoute{
+				Enabled:    ptr.To[bool](true),
+				ParentRefs: []appsv1alpha1.GatewayParentReference{{Name: "test-gateway"}},
+			}
+			result, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{RequeueAfter: shared.GatewayRouteRequeueInterval}))
+			nimService.Spec.Expose.GatewayRoute = appsv1alpha1.GatewayRoute{}
+
+			// Switching back to a single-node deployment removes the LeaderWorkerSet
+			nimService.Spec.MultiNode = nil
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), namespacedName, newUnstructured(LeaderWorkerSetGVK))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(client.Get(context.TODO(), namespacedName, &appsv1.Deployment{})).To(Succeed())
+			Expect(nimService.Status.MultiNode).To(BeNil())
+		})
+
+		It("should replace the HPA with a KEDA ScaledObject", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Get(context.TODO(), namespacedName, &autoscalingv2.HorizontalPodAutoscaler{})).To(Succeed())
+
+			nimService.Spec.Scale.HPA = appsv1alpha1.HorizontalPodAutoscalerSpec{}
+			nimService.Spec.Scale.KEDA = &appsv1alpha1.KEDASpec{
+				MinReplicas:   ptr.To[int32](1),
+				MaxReplicas:   4,
+				PrometheusURL: "http://prometheus.monitoring.svc:9090",
+				Triggers: []appsv1alpha1.KEDATrigger{
+					{Metric: appsv1alpha1.KEDAMetricRequestsWaiting},
+					{Metric: appsv1alpha1.KEDAMetricGPUCacheUsage, Threshold: "0.8"},
+				},
+			}
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			// HPA is replaced by the ScaledObject
+			err = client.Get(context.TODO(), namespacedName, &autoscalingv2.HorizontalPodAutoscaler{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			scaledObject := newUnstructured(ScaledObjectGVK)
+			Expect(client.Get(context.TODO(), namespacedName, scaledObject)).To(Succeed())
+			Expect(scaledObject.GetOwnerReferences()).To(HaveLen(1))
+			kind, _, _ := unstructured.NestedString(scaledObject.Object, "spec", "scaleTargetRef", "kind")
+			Expect(kind).To(Equal("Deployment"))
+			maxReplicas, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "maxReplicaCount")
+			Expect(maxReplicas).To(Equal(int64(4)))
+			triggers, _, _ := unstructured.NestedSlice(scaledObject.Object, "spec", "triggers")
+			Expect(triggers).To(HaveLen(2))
+			Expect(triggers[0]).To(HaveKeyWithValue("metadata", map[string]interface{}{
+				"serverAddress": "http://prometheus.monitoring.svc:9090",
+				"query":         `sum(num_requests_waiting{namespace="default",pod=~"test-nimservice(-candidate)?-[a-z0-9]{1,10}-[a-z0-9]{5}"})`,
+				"threshold":     "10",
+			}))
+			Expect(triggers[1]).To(HaveKeyWithValue("metricType", "Value"))
+			Expect(triggers[1]).To(HaveKeyWithValue("metadata", HaveKeyWithValue("threshold", "0.8")))
+
+			// Switching back to an HPA removes the ScaledObject
+			nimService.Spec.Scale.KEDA = nil
+			nimService.Spec.Scale.HPA = appsv1alpha1.HorizontalPodAutoscalerSpec{MinReplicas: ptr.To[int32](1), MaxReplicas: 10}
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), namespacedName, newUnstructured(ScaledObjectGVK))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(client.Get(context.TODO(), namespacedName, &autoscalingv2.HorizontalPodAutoscaler{})).To(Succeed())
+		})
+
+		It("should create and remove the PodDisruptionBudget", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			minAvailable := intstr.FromString("50%")
+			nimService.Spec.PDB = appsv1alpha1.PodDisruptionBudget{Enabled: ptr.To[bool](true), MinAvailable: &minAvailable}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			pdb := &policyv1.PodDisruptionBudget{}
+			Expect(client.Get(context.TODO(), namespacedName, pdb)).To(Succeed())
+			Expect(pdb.GetOwnerReferences()).To(HaveLen(1))
+			Expect(*pdb.Spec.MinAvailable).To(Equal(minAvailable))
+			Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+			Expect(pdb.Spec.Selector.MatchLabels).To(Equal(nimService.GetSelectorLabels()))
+
+			// Disabling the budget removes it
+			nimService.Spec.PDB.Enabled = ptr.To[bool](false)
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), namespacedName, &policyv1.PodDisruptionBudget{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should create Gateway API routes and report their status", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			nimService.Spec.Expose.GatewayRoute = appsv1alpha1.GatewayRoute{
+				Enabled:    ptr.To[bool](true),
+				ParentRefs: []appsv1alpha1.GatewayParentReference{{Name: "test-gateway"}},
+				GRPC:       appsv1alpha1.GRPCRoute{Enabled: ptr.To[bool](true)},
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(shared.GatewayRouteRequeueInterval))
+
+			httpRoute := newUnstructured(shared.HTTPRouteGVK)
+			Expect(client.Get(context.TODO(), namespacedName, httpRoute)).To(Succeed())
+			Expect(httpRoute.GetOwnerReferences()).To(HaveLen(1))
+			grpcRoute := newUnstructured(shared.GRPCRouteGVK)
+			Expect(client.Get(context.TODO(), namespacedName, grpcRoute)).To(Succeed())
+			rules, _, _ := unstructured.NestedSlice(grpcRoute.Object, "spec", "rules")
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0]).To(HaveKeyWithValue("backendRefs", ConsistOf(HaveKeyWithValue("port", BeNumerically("==", 8001)))))
+			service := &corev1.Service{}
+			Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+			Expect(service.Spec.Ports).To(HaveLen(2))
+			Expect(service.Spec.Ports[1].Name).To(Equal("grpc"))
+			Expect(service.Spec.Ports[1].Port).To(Equal(int32(8001)))
+			accepted := meta.FindStatusCondition(nimService.Status.Conditions, conditions.GatewayRouteAccepted)
+			Expect(accepted).NotTo(BeNil())
+			Expect(accepted.Status).To(Equal(metav1.ConditionUnknown))
+
+			// Mirror the conditions reported by the gateway
+			parentStatus := func(resolved string) []interface{} {
+				return []interface{}{map[string]interface{}{
+					"parentRef":      map[string]interface{}{"name": "test-gateway"},
+					"controllerName": "example.com/gateway-controller",
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Accepted", "status": "True", "reason": "Accepted"},
+						map[string]interface{}{"type": "ResolvedRefs", "status": resolved, "reason": "BackendNotFound", "message": "service not found"},
+					},
+				}}
+			}
+			for _, gvk := range []schema.GroupVersionKind{shared.HTTPRouteGVK, shared.GRPCRouteGVK} {
+				route := newUnstructured(gvk)
+				Expect(client.Get(context.TODO(), namespacedName, route)).To(Succeed())
+				resolved := "True"
+				if gvk == shared.GRPCRouteGVK {
+					resolved = "False"
+				}
+				Expect(unstructured.SetNestedSlice(route.Object, parentStatus(resolved), "status", "parents")).To(Succeed())
+				Expect(client.Update(context.TODO(), route)).To(Succeed())
+			}
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.GatewayRouteAccepted)).To(BeTrue())
+			resolvedRefs := meta.FindStatusCondition(nimService.Status.Conditions, conditions.GatewayRouteResolvedRefs)
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+			Expect(resolvedRefs.Reason).To(Equal("BackendNotFound"))
+			Expect(resolvedRefs.Message).To(Equal("GRPCRoute test-nimservice: service not found"))
+
+			// Disabling the routes removes them along with their conditions
+			nimService.Spec.Expose.GatewayRoute.Enabled = ptr.To[bool](false)
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), namespacedName, newUnstructured(shared.HTTPRouteGVK))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = client.Get(context.TODO(), namespacedName, newUnstructured(shared.GRPCRouteGVK))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(meta.FindStatusCondition(nimService.Status.Conditions, conditions.GatewayRouteAccepted)).To(BeNil())
+		})
+
+		It("should request a cert-manager certificate and rotate the pods on renewal", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			nimService.Spec.Expose.TLS = appsv1alpha1.TLS{
+				Enabled: ptr.To[bool](true),
+				CertManager: &appsv1alpha1.CertManagerCertificate{
+					IssuerRef: appsv1alpha1.CertManagerIssuerReference{Name: "test-issuer", Kind: "ClusterIssuer"},
+				},
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			certificate := newUnstructured(CertificateGVK)
+			Expect(client.Get(context.TODO(), namespacedName, certificate)).To(Succeed())
+			secretName, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+			Expect(secretName).To(Equal("test-nimservice-tls"))
+
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), namespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", "tls")))
+			Expect(deployment.Spec.Template.Annotations).NotTo(HaveKey(appsv1alpha1.TLSSecretHashAnnotationKey))
+
+			// The pods are annotated with the hash of the certificate once issued
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-nimservice-tls", Namespace: nimService.Namespace},
+				Type:       corev1.SecretTypeTLS,
+				Data:       map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")},
+			}
+			Expect(client.Create(context.TODO(), secret)).To(Succeed())
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Get(context.TODO(), namespacedName, deployment)).To(Succeed())
+			hash := deployment.Spec.Template.Annotations[appsv1alpha1.TLSSecretHashAnnotationKey]
+			Expect(hash).NotTo(BeEmpty())
+
+			secret.Data[corev1.TLSCertKey] = []byte("renewed-cert")
+			Expect(client.Update(context.TODO(), secret)).To(Succeed())
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Get(context.TODO(), namespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Annotations[appsv1alpha1.TLSSecretHashAnnotationKey]).NotTo(Equal(hash))
+
+			// Switching to an existing secret removes the certificate
+			nimService.Spec.Expose.TLS.CertManager = nil
+			nimService.Spec.Expose.TLS.SecretName = "test-nimservice-tls"
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), namespacedName, newUnstructured(CertificateGVK))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should expand autoscaling presets into HPA metrics and prometheus-adapter rules", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			adapterConfigName := types.NamespacedName{Name: "prometheus-adapter", Namespace: "monitoring"}
+			Expect(client.Create(context.TODO(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: adapterConfigName.Name, Namespace: adapterConfigName.Namespace},
+				Data:       map[string]string{"config.yaml": "rules:\n- seriesQuery: custom_metric\n  name:\n    as: custom_metric\n"},
+			})).To(Succeed())
+			nimService.Spec.Scale.Presets = []appsv1alpha1.AutoscalingPreset{
+				{Name: appsv1alpha1.AutoscalingPresetQueueDepth},
+				{Name: appsv1alpha1.AutoscalingPresetTimeToFirstToken, Target: ptr.To(resource.MustParse("500m"))},
+			}
+			nimService.Spec.Scale.PrometheusAdapter = appsv1alpha1.PrometheusAdapter{
+				Enabled:   ptr.To[bool](true),
+				ConfigMap: adapterConfigName.Name,
+				Namespace: adapterConfigName.Namespace,
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+			Expect(client.Get(context.TODO(), namespacedName, hpa)).To(Succeed())
+			Expect(hpa.Spec.Metrics).To(HaveLen(len(nimService.Spec.Scale.HPA.Metrics) + 2))
+			Expect(hpa.Spec.Metrics).To(ContainElement(autoscalingv2.MetricSpec{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: "num_requests_waiting"},
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: ptr.To(resource.MustParse("10"))},
+				},
+			}))
+			Expect(hpa.Spec.Metrics).To(ContainElement(autoscalingv2.MetricSpec{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: "time_to_first_token_seconds"},
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: ptr.To(resource.MustParse("500m"))},
+				},
+			}))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), adapterConfigName, configMap)).To(Succeed())
+			Expect(configMap.Data["config.yaml"]).To(ContainSubstring(`seriesQuery: num_requests_waiting{namespace!="",pod!=""}`))
+			Expect(configMap.Data["config.yaml"]).To(ContainSubstring("as: time_to_first_token_seconds"))
+			Expect(configMap.Data["config.yaml"]).To(ContainSubstring("as: custom_metric"))
+
+			// The configuration is left unchanged once the rules are added
+			resourceVersion := configMap.ResourceVersion
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Get(context.TODO(), adapterConfigName, configMap)).To(Succeed())
+			Expect(configMap.ResourceVersion).To(Equal(resourceVersion))
+		})
+
+		It("should download LoRA adapters and mount them in the NIM pods", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			nimService.Spec.LoRA = &appsv1alpha1.LoRASpec{
+				Adapters: []appsv1alpha1.LoRAAdapter{
+					{Name: "llama-math", NGC: &appsv1alpha1.LoRANGCSource{Model: "nvidia/llama-math:1.0", ModelPuller: "nvcr.io/nvidia/ngc-cli:latest"}},
+					{Name: "llama-sql", PVC: &appsv1alpha1.LoRAPVCSource{Name: "adapters-pvc", SubPath: "llama-sql"}},
+				},
+				Storage:         appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), Size: "10Gi", VolumeAccessMode: corev1.ReadWriteMany},
+				RefreshInterval: ptr.To[int32](60),
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Adapter storage is created and NGC adapters are downloaded by a job
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: "test-nimservice-lora-pvc", Namespace: "default"}, &corev1.PersistentVolumeClaim{})).To(Succeed())
+			job := &batchv1.Job{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: "test-nimservice-lora-llama-math", Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal("nvcr.io/nvidia/ngc-cli:latest"))
+			Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "NGC_MODEL", Value: "nvidia/llama-math:1.0"}))
+			Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "ADAPTER_PATH", Value: "/loras/llama-math"}))
+			Expect(job.Spec.Template.Labels).NotTo(HaveKey("app.kubernetes.io/instance"))
+			err = client.Get(context.TODO(), types.NamespacedName{Name: "test-nimservice-lora-llama-sql", Namespace: "default"}, &batchv1.Job{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(nimService.Status.LoRAAdapters).To(Equal([]appsv1alpha1.LoRAAdapterStatus{
+				{Name: "llama-math", State: appsv1alpha1.LoRAAdapterStateDownloading},
+				{Name: "llama-sql", State: appsv1alpha1.LoRAAdapterStatePending, Message: "pvc adapters-pvc not found"},
+			}))
+
+			// NIM loads the adapters from the adapters directory
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), namespacedName, deployment)).To(Succeed())
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "NIM_PEFT_SOURCE", Value: "/loras"}))
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "NIM_PEFT_REFRESH_INTERVAL", Value: "60"}))
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "lora-store", MountPath: "/loras"}))
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "lora-llama-sql", MountPath: "/loras/llama-sql", SubPath: "llama-sql", ReadOnly: true}))
+
+			// Adapter states follow the download job and source PVC
+			job.Status.Succeeded = 1
+			Expect(client.Status().Update(context.TODO(), job)).To(Succeed())
+			Expect(client.Create(context.TODO(), &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "adapters-pvc", Namespace: "default"}})).To(Succeed())
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.LoRAAdapters).To(Equal([]appsv1alpha1.LoRAAdapterStatus{
+				{Name: "llama-math", State: appsv1alpha1.LoRAAdapterStateReady},
+				{Name: "llama-sql", State: appsv1alpha1.LoRAAdapterStateReady},
+			}))
+
+			// Download jobs of removed adapters are deleted
+			nimService.Spec.LoRA.Adapters = nimService.Spec.LoRA.Adapters[1:]
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), types.NamespacedName{Name: "test-nimservice-lora-llama-math", Namespace: "default"}, &batchv1.Job{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(nimService.Status.LoRAAdapters).To(HaveLen(1))
+		})
+	})
+
+	Describe("reconcileRollout", func() {
+		var (
+			namespacedName    types.NamespacedName
+			candidateName     types.NamespacedName
+			smokeTestErr      error
+			smokeTestEndpoint string
+			smokeTestClient   *http.Client
+		)
+
+		setDeploymentReady := func(name types.NamespacedName, ready bool) {
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), name, deployment)).To(Succeed())
+			replicas := *deployment.Spec.Replicas
+			deployment.Status = appsv1.DeploymentStatus{Replicas: replicas}
+			if ready {
+				deployment.Status.UpdatedReplicas = replicas
+				deployment.Status.AvailableReplicas = replicas
+			}
+			Expect(client.Status().Update(context.TODO(), deployment)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			namespacedName = types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			candidateName = types.NamespacedName{Name: nimService.GetCandidateName(), Namespace: nimService.Namespace}
+			nimService.Spec.Scale.Enabled = ptr.To[bool](false)
+			nimService.Spec.Replicas = 2
+			nimService.Spec.Rollout = &appsv1alpha1.RolloutSpec{
+				Steps:        []int32{10, 50},
+				StepInterval: &metav1.Duration{},
+				SmokeTest:    &appsv1alpha1.SmokeTest{Path: "/v1/models"},
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+			smokeTestErr = nil
+			smokeTestEndpoint = ""
+			smokeTestClient = nil
+			origRunSmokeTest := runSmokeTest
+			runSmokeTest = func(ctx context.Context, httpClient *http.Client, endpoint string, smokeTest *appsv1alpha1.SmokeTest) error {
+				smokeTestEndpoint = endpoint
+				smokeTestClient = httpClient
+				return smokeTestErr
+			}
+			DeferCleanup(func() {
+				runSmokeTest = origRunSmokeTest
+			})
+
+			// Initial revision is deployed in place
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Rollout).NotTo(BeNil())
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseStable))
+			Expect(nimService.Status.Rollout.StableRevision).NotTo(BeEmpty())
+			Expect(client.Get(context.TODO(), namespacedName, &appsv1.Deployment{})).To(Succeed())
+			err = client.Get(context.TODO(), candidateName, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			setDeploymentReady(namespacedName, true)
+		})
+
+		It("should poll the gateway routes of a stable revision", func() {
+			nimService.Spec.Expose.GatewayRoute = appsv1alpha1.GatewayRoute{
+				Enabled:    ptr.To[bool](true),
+				ParentRefs: []appsv1alpha1.GatewayParentReference{{Name: "test-gateway"}},
+			}
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseStable))
+			Expect(result).To(Equal(ctrl.Result{RequeueAfter: shared.GatewayRouteRequeueInterval}))
+		})
+
+		It("should shift traffic in steps and promote a canary revision", func() {
+			stableRevision := nimService.Status.Rollout.StableRevision
+			nimService.Spec.Image.Tag = "v0.2.0"
+
+			// Candidate is deployed next to the stable revision
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{RequeueAfter: rolloutRequeueInterval}))
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseProgressing))
+			Expect(nimService.Status.Rollout.CandidateRevision).NotTo(BeEmpty())
+			Expect(nimService.Status.Rollout.Traffic).To(Equal(appsv1alpha1.RolloutTrafficStable))
+			candidate := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), candidateName, candidate)).To(Succeed())
+			Expect(candidate.Spec.Template.Spec.Containers[0].Image).To(Equal("nvcr.io/nvidia/nim-llm:v0.2.0"))
+			Expect(*candidate.Spec.Replicas).To(Equal(int32(1)))
+			Expect(client.Get(context.TODO(), candidateName, &corev1.Service{})).To(Succeed())
+			stable := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), namespacedName, stable)).To(Succeed())
+			Expect(stable.Spec.Template.Spec.Containers[0].Image).To(Equal("nvcr.io/nvidia/nim-llm:v0.1.0"))
+
+			// Ready candidate receives a share of the traffic
+			setDeploymentReady(candidateName, true)
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Rollout.SmokeTestPassed).To(BeTrue())
+			Expect(smokeTestEndpoint).To(Equal(fmt.Sprintf("http://%s.%s.svc:%d", candidateName.Name, candidateName.Namespace, nimService.GetServicePort())))
+			Expect(smokeTestClient).To(Equal(http.DefaultClient))
+			Expect(nimService.Status.Rollout.Traffic).To(Equal(appsv1alpha1.RolloutTrafficSplit))
+			Expect(nimService.Status.Rollout.CanaryWeight).To(Equal(int32(10)))
+			service := &corev1.Service{}
+			Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+			Expect(service.Spec.Selector).To(Equal(map[string]string{"app.kubernetes.io/instance": nimService.Name}))
+
+			// Candidate is scaled up for the next step
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Get(context.TODO(), candidateName, candidate)).To(Succeed())
+			Expect(*candidate.Spec.Replicas).To(Equal(int32(2)))
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseProgressing))
+
+			// Candidate is promoted after the last step
+			setDeploymentReady(candidateName, true)
+			setDeploymentReady(namespacedName, false)
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhasePromoting))
+			Expect(nimService.Status.Rollout.CanaryWeight).To(Equal(int32(50)))
+			Expect(client.Get(context.TODO(), namespacedName, stable)).To(Succeed())
+			Expect(stable.Spec.Template.Spec.Containers[0].Image).To(Equal("nvcr.io/nvidia/nim-llm:v0.2.0"))
+
+			// Candidate is removed once the stable deployment is updated
+			setDeploymentReady(namespacedName, true)
+			result, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseStable))
+			Expect(nimService.Status.Rollout.StableRevision).NotTo(Equal(stableRevision))
+			Expect(nimService.Status.Rollout.CandidateRevision).To(BeEmpty())
+			Expect(nimService.Status.Rollout.Traffic).To(Equal(appsv1alpha1.RolloutTrafficStable))
+			err = client.Get(context.TODO(), candidateName, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = client.Get(context.TODO(), candidateName, &corev1.Service{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+			Expect(service.Spec.Selector).To(Equal(nimService.GetSelectorLabels()))
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Ready)).To(BeTrue())
+		})
+
+		It("should switch all traffic to a blue/green revision", func() {
+			nimService.Spec.Rollout.Strategy = appsv1alpha1.RolloutStrategyBlueGreen
+			nimService.Spec.Image.Tag = "v0.2.0"
+
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			candidate := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), candidateName, candidate)).To(Succeed())
+			Expect(*candidate.Spec.Replicas).To(Equal(int32(2)))
+			setDeploymentReady(candidateName, true)
+			setDeploymentReady(namespacedName, false)
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhasePromoting))
+			Expect(nimService.Status.Rollout.Traffic).To(Equal(appsv1alpha1.RolloutTrafficCandidate))
+			service := &corev1.Service{}
+			Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+			Expect(service.Spec.Selector).To(Equal(map[string]string{"app": candidateName.Name}))
+
+			setDeploymentReady(namespacedName, true)
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseStable))
+			Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+			Expect(service.Spec.Selector).To(Equal(nimService.GetSelectorLabels()))
+		})
+
+		It("should roll back a revision failing the smoke test", func() {
+			nimService.Spec.Image.Tag = "v0.2.0"
+			smokeTestErr = fmt.Errorf("connection refused")
+
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			setDeploymentReady(candidateName, true)
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseRolledBack))
+			Expect(nimService.Status.Rollout.FailedRevision).NotTo(BeEmpty())
+			Expect(nimService.Status.Rollout.Traffic).To(Equal(appsv1alpha1.RolloutTrafficStable))
+			Expect(nimService.Status.Rollout.Message).To(ContainSubstring("connection refused"))
+			err = client.Get(context.TODO(), candidateName, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// Failed revision is not retried
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseRolledBack))
+			err = client.Get(context.TODO(), candidateName, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			stable := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), namespacedName, stable)).To(Succeed())
+			Expect(stable.Spec.Template.Spec.Containers[0].Image).To(Equal("nvcr.io/nvidia/nim-llm:v0.1.0"))
+		})
+
+		It("should run the smoke test over TLS trusting the serving certificate", func() {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			server.Close()
+			Expect(client.Create(context.TODO(), &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-nimservice-tls", Namespace: nimService.Namespace},
+				Type:       corev1.SecretTypeTLS,
+				Data: map[string][]byte{
+					utils.TLSCAKey:          pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+					corev1.TLSCertKey:       []byte("cert"),
+					corev1.TLSPrivateKeyKey: []byte("key"),
+				},
+			})).To(Succeed())
+			nimService.Spec.Expose.TLS = appsv1alpha1.TLS{Enabled: ptr.To[bool](true)}
+			nimService.Spec.Image.Tag = "v0.2.0"
+
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			setDeploymentReady(candidateName, true)
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Rollout.SmokeTestPassed).To(BeTrue())
+			Expect(smokeTestEndpoint).To(Equal(fmt.Sprintf("https://%s.%s.svc:%d", candidateName.Name, candidateName.Namespace, nimService.GetServicePort())))
+			tlsConfig := smokeTestClient.Transport.(*http.Transport).TLSClientConfig
+			Expect(tlsConfig.ServerName).To(Equal("test-nimservice.default.svc"))
+			_, err = server.Certificate().Verify(x509.VerifyOptions{Roots: tlsConfig.RootCAs})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should remove the candidate when rollouts are disabled", func() {
+			nimService.Spec.Image.Tag = "v0.2.0"
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Get(context.TODO(), candidateName, &appsv1.Deployment{})).To(Succeed())
+
+			nimService.Spec.Rollout = nil
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Rollout).To(BeNil())
+			err = client.Get(context.TODO(), candidateName, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should reject canary steps above 50", func() {
+			nimService.Spec.Rollout.Steps = []int32{25, 75}
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).To(MatchError("rollout step 75 is not between 1 and 50"))
+		})
+
+		It("should reject rollouts for statefulsets", func() {
+			nimService.Spec.DeploymentKind = appsv1alpha1.DeploymentKindStatefulSet
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("reconcileSuspension", func() {
+		var (
+			namespacedName types.NamespacedName
+			activatorName  types.NamespacedName
+			requests       float64
+		)
+
+		BeforeEach(func() {
+			namespacedName = types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			activatorName = types.NamespacedName{Name: nimService.GetActivatorName(), Namespace: nimService.Namespace}
+
+			requests = 0
+			origQueryPrometheus := queryPrometheus
+			queryPrometheus = func(ctx context.Context, prometheusURL string, query string) (float64, error) {
+				Expect(prometheusURL).To(Equal("http://prometheus.monitoring.svc:9090"))
+				Expect(query).To(ContainSubstring(`request_success_total{namespace="default",pod=~"test-nimservice(-candidate)?-[a-z0-9]{1,10}-[a-z0-9]{5}"}[1800s]`))
+				return requests, nil
+			}
+			DeferCleanup(func() {
+				queryPrometheus = origQueryPrometheus
+			})
+		})
+
+		It("should scale a suspended NIMService to zero", func() {
+			nimService.Spec.Suspend = true
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(nimService.IsSuspended()).To(BeTrue())
+			Expect(nimService.Status.Suspension.Reason).To(Equal(appsv1alpha1.SuspendReasonManual))
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Ready)).To(BeFalse())
+
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), namespacedName, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(0)))
+			err = client.Get(context.TODO(), namespacedName, &autoscalingv2.HorizontalPodAutoscaler{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// Resumed when the suspension is lifted
+			nimService.Spec.Suspend = false
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.Status.Suspension).To(BeNil())
+			Expect(client.Get(context.TODO(), namespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).NotTo(Equal(ptr.To[int32](0)))
+			Expect(client.Get(context.TODO(), namespacedName, &autoscalingv2.HorizontalPodAutoscaler{})).To(Succeed())
+		})
+
+		It("should scale an idle NIMService to zero and resume it on request", func() {
+			nimService.Spec.ScaleToZero = &appsv1alpha1.ScaleToZeroSpec{
+				PrometheusURL: "http://prometheus.monitoring.svc:9090",
+				Activator:     appsv1alpha1.ActivatorSpec{Image: "ghcr.io/nvidia/k8s-nim-operator:main"},
+			}
+			nimService.Spec.NetworkPolicy.Enabled = ptr.To[bool](true)
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+			// Requests within the idle timeout keep the NIMService running
+			requests = 5
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{RequeueAfter: idleCheckInterval}))
+			Expect(nimService.IsSuspended()).To(BeFalse())
+			activator := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), activatorName, activator)).To(Succeed())
+			Expect(activator.Spec.Template.Spec.ServiceAccountName).To(Equal(activatorName.Name))
+			Expect(activator.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--activator-nimservice=default/test-nimservice"))
+			Expect(client.Get(context.TODO(), activatorName, &rbacv1.Role{})).To(Succeed())
+			Expect(client.Get(context.TODO(), activatorName, &rbacv1.RoleBinding{})).To(Succeed())
+			networkPolicy := &networkingv1.NetworkPolicy{}
+			Expect(client.Get(context.TODO(), activatorName, networkPolicy)).To(Succeed())
+			Expect(networkPolicy.Spec.PodSelector.MatchLabels).To(Equal(map[string]string{"app": activatorName.Name}))
+			Expect(client.Get(context.TODO(), namespacedName, networkPolicy)).To(Succeed())
+			Expect(networkPolicy.Spec.PodSelector.MatchLabels).To(Equal(map[string]string{"app.kubernetes.io/instance": "test-nimservice"}))
+			service := &corev1.Service{}
+			Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+			Expect(service.Spec.Selector).To(HaveKeyWithValue("app", "test-nimservice"))
+
+			// Suspended after the idle timeout without requests
+			requests = 0
+			lastActivity := metav1.NewTime(time.Now().Add(-time.Hour))
+			nimService.Status.Suspension.LastActivityTime = &lastActivity
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.IsIdleSuspended()).To(BeTrue())
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), namespacedName, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(0)))
+			Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+			Expect(service.Spec.Selector).To(Equal(map[string]string{"app": activatorName.Name}))
+
+			// Resumed once the activator requests it
+			suspendedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+			nimService.Status.Suspension.SuspendedAt = &suspendedAt
+			nimService.Annotations = map[string]string{appsv1alpha1.NIMServiceWakeAnnotationKey: time.Now().UTC().Format(time.RFC3339)}
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimService.IsSuspended()).To(BeFalse())
+			Expect(client.Get(context.TODO(), namespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).NotTo(Equal(ptr.To[int32](0)))
+			Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+			Expect(service.Spec.Selector).To(HaveKeyWithValue("app", "test-nimservice"))
+
+			// Activator is removed when scaling to zero is disabled
+			nimService.Spec.ScaleToZero = nil
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), activatorName, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = client.Get(context.TODO(), activatorName, &networkingv1.NetworkPolicy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should serve and forward the activator requests over TLS", func() {
+			nimService.Spec.ScaleToZero = &appsv1alpha1.ScaleToZeroSpec{
+				PrometheusURL: "http://prometheus.monitoring.svc:9090",
+				Activator:     appsv1alpha1.ActivatorSpec{Image: "ghcr.io/nvidia/k8s-nim-operator:main"},
+			}
+			nimService.Spec.Expose.TLS = appsv1alpha1.TLS{Enabled: ptr.To[bool](true), SecretName: "test-tls"}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+			requests = 5
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			activator := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), activatorName, activator)).To(Succeed())
+			Expect(activator.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+				fmt.Sprintf("--activator-tls-dir=%s", appsv1alpha1.TLSCertsPath),
+				fmt.Sprintf("--activator-target=https://test-nimservice.default.svc:%d", nimService.GetServicePort()),
+			))
+			Expect(activator.Spec.Template.Spec.Volumes).To(HaveLen(1))
+			Expect(activator.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal("test-tls"))
+			Expect(activator.Spec.Template.Spec.Containers[0].VolumeMounts).To(Equal(nimService.GetTLSVolumeMounts()))
+		})
+
+		It("should reject suspending NIMServices with rollouts", func() {
+			nimService.Spec.Suspend = true
+			nimService.Spec.Rollout = &appsv1alpha1.RolloutSpec{}
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("getCanaryReplicas", func() {
+		It("should add replicas on top of the stable revision", func() {
+			Expect(getCanaryReplicas(4, 10)).To(Equal(int32(1)))
+			Expect(getCanaryReplicas(4, 20)).To(Equal(int32(1)))
+			Expect(getCanaryReplicas(9, 25)).To(Equal(int32(3)))
+			Expect(getCanaryReplicas(4, 50)).To(Equal(int32(4)))
+			Expect(getCanaryReplicas(4, 80)).To(Equal(int32(4)))
+		})
+	})
+
+	Describe("isStatefulSetReady for setting status on NIMService", func() {
+
+		AfterEach(func() {
+			// Clean up the StatefulSet instance
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimservice",
+					Namespace: "default",
+				},
+			}
+			_ = client.Delete(context.TODO(), statefulSet)
+		})
+
+		It("Waiting for statefulset rollout to finish: pods are not ready", func() {
+			nimServiceKey := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimservice",
+					Namespace: "default",
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To[int32](2),
+				},
+				Status: appsv1.StatefulSetStatus{
+					UpdatedReplicas: 2,
+					ReadyReplicas:   1,
+				},
+			}
+			err := client.Create(context.TODO(), statefulSet)
+			Expect(err).NotTo(HaveOccurred())
+			msg, ready, err := reconciler.isStatefulSetReady(context.TODO(), &nimServiceKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ready).To(Equal(false))
+			Expect(msg).To(Equal(fmt.Sprintf("Waiting for statefulset %q rollout to finish: %d of %d updated pods are ready...\n", statefulSet.Name, 1, 2)))
+		})
+
+		It("StatefulSet successfully rolled out", func() {
+			nimServiceKey := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimservice",
+					Namespace: "default",
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To[int32](2),
+				},
+				Status: appsv1.StatefulSetStatus{
+					UpdatedReplicas: 2,
+					ReadyReplicas:   2,
+					CurrentRevision: "rev-1",
+					UpdateRevision:  "rev-1",
+				},
+			}
+			err := client.Create(context.TODO(), statefulSet)
+			Expect(err).NotTo(HaveOccurred())
+			msg, ready, err := reconciler.isStatefulSetReady(context.TODO(), &nimServiceKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ready).To(Equal(true))
+			Expect(msg).To(Equal(fmt.Sprintf("statefulset %q successfully rolled out\n", statefulSet.Name)))
+		})
 	})
 
 	Describe("isDeploymentReady for setting status on NIMService", func() {
@@ -658,6 +1560,46 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 		})
 	})
 
+	Describe("getNIMCachePVC", func() {
+		It("should require the NIMCache to be verified when requested", func() {
+			nimService.Spec.Storage.NIMCache.Name = "test-nimcache"
+			pvc, err := reconciler.getNIMCachePVC(context.TODO(), nimService)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc).ToNot(BeNil())
+
+			nimService.Spec.Storage.NIMCache.RequireVerified = true
+			_, err = reconciler.getNIMCachePVC(context.TODO(), nimService)
+			Expect(err).To(MatchError(ContainSubstring("nimcache test-nimcache cannot be verified")))
+
+			// NGC caches are verified against their blobs
+			nimCache.Spec.Verification = &appsv1alpha1.VerificationSpec{}
+			Expect(reconciler.Client.Update(context.TODO(), nimCache)).To(Succeed())
+			_, err = reconciler.getNIMCachePVC(context.TODO(), nimService)
+			Expect(err).To(MatchError(ContainSubstring("nimcache test-nimcache is not verified")))
+
+			// DataStore sources provide no checksums
+			nimCache.Spec.Source = appsv1alpha1.NIMSource{DataStore: &appsv1alpha1.DataStoreSource{Endpoint: "http://datastore:8000", ModelPuller: "test-container"}}
+			Expect(reconciler.Client.Update(context.TODO(), nimCache)).To(Succeed())
+			_, err = reconciler.getNIMCachePVC(context.TODO(), nimService)
+			Expect(err).To(MatchError(ContainSubstring("nimcache test-nimcache cannot be verified")))
+
+			nimCache.Spec.Verification.Checksums = "test-checksums"
+			Expect(reconciler.Client.Update(context.TODO(), nimCache)).To(Succeed())
+			_, err = reconciler.getNIMCachePVC(context.TODO(), nimService)
+			Expect(err).To(MatchError(ContainSubstring("nimcache test-nimcache is not verified")))
+
+			meta.SetStatusCondition(&nimCache.Status.Conditions, metav1.Condition{
+				Type:   appsv1alpha1.NimCacheConditionVerified,
+				Status: metav1.ConditionTrue,
+				Reason: "Verified",
+			})
+			Expect(reconciler.Client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+			pvc, err = reconciler.getNIMCachePVC(context.TODO(), nimService)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc).ToNot(BeNil())
+		})
+	})
+
 	Describe("getNIMCacheProfile", func() {
 		It("should return nil when NIMCache is not used", func() {
 			nimService.Spec.Storage.NIMCache.Name = ""
@@ -774,6 +1716,54 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 			Expect(deploymentParams.Resources.Limits).To(HaveKeyWithValue(corev1.ResourceName("nvidia.com/gpu"), apiResource.MustParse("1")))
 		})
 
+		It("should spread GPU resources across the pods of a multi-node group", func() {
+			profile := &appsv1alpha1.NIMProfile{
+				Name:   "test-profile",
+				Config: map[string]string{"tp": "8", "pp": "2"},
+			}
+			nimService.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{Size: 4}
+			deploymentParams := &rendertypes.DeploymentParams{}
+
+			Expect(reconciler.assignGPUResources(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			Expect(deploymentParams.Resources.Limits).To(HaveKeyWithValue(corev1.ResourceName("nvidia.com/gpu"), apiResource.MustParse("4")))
+
+			// Explicit GPUs per pod takes precedence over the profile
+			nimService.Spec.MultiNode.GPUsPerPod = 8
+			deploymentParams = &rendertypes.DeploymentParams{}
+			Expect(reconciler.assignGPUResources(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			Expect(deploymentParams.Resources.Limits).To(HaveKeyWithValue(corev1.ResourceName("nvidia.com/gpu"), apiResource.MustParse("8")))
+		})
+
+		It("should assign the configured GPU resource", func() {
+			profile := &appsv1alpha1.NIMProfile{
+				Name:   "test-profile",
+				Config: map[string]string{"tp": "2"},
+			}
+			DeferCleanup(os.Setenv, appsv1alpha1.GPUResourceNameEnv, os.Getenv(appsv1alpha1.GPUResourceNameEnv))
+			Expect(os.Setenv(appsv1alpha1.GPUResourceNameEnv, "nvidia.com/gpu.shared")).To(Succeed())
+			deploymentParams := &rendertypes.DeploymentParams{}
+			Expect(reconciler.assignGPUResources(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			Expect(deploymentParams.Resources.Limits).To(Equal(corev1.ResourceList{"nvidia.com/gpu.shared": apiResource.MustParse("2")}))
+
+			// NIMService setting takes precedence over the operator setting
+			nimService.Spec.GPUResourceName = "nvidia.com/mig-3g.40gb"
+			profile.Config["tp"] = "1"
+			deploymentParams = &rendertypes.DeploymentParams{}
+			Expect(reconciler.assignGPUResources(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			Expect(deploymentParams.Resources.Limits).To(Equal(corev1.ResourceList{"nvidia.com/mig-3g.40gb": apiResource.MustParse("1")}))
+		})
+
+		It("should return an error for multi-GPU profiles on MIG devices", func() {
+			profile := &appsv1alpha1.NIMProfile{
+				Name:   "test-profile",
+				Config: map[string]string{"tp": "2"},
+			}
+			nimService.Spec.GPUResourceName = "nvidia.com/mig-3g.40gb"
+			deploymentParams := &rendertypes.DeploymentParams{}
+			err := reconciler.assignGPUResources(context.TODO(), nimService, profile, deploymentParams)
+			Expect(err).To(MatchError(ContainSubstring("MIG resource nvidia.com/mig-3g.40gb provides a single device per pod")))
+		})
+
 		It("should return an error if tensor parallelism cannot be parsed", func() {
 			profile := &appsv1alpha1.NIMProfile{
 				Name:   "test-profile",
@@ -786,4 +1776,284 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("validateGPUResource", func() {
+		It("should fail when the GPU resource is not allocatable on any node", func() {
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			resources := &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{"nvidia.com/gpu": apiResource.MustParse("1")},
+			}
+			err := reconciler.validateGPUResource(context.TODO(), nimService, resources)
+			Expect(err).To(MatchError("GPU resource nvidia.com/gpu is not allocatable on any node"))
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Failed)).To(BeTrue())
+
+			Expect(client.Create(context.TODO(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "gpu-node"},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{"nvidia.com/gpu": apiResource.MustParse("8")},
+				},
+			})).To(Succeed())
+			Expect(reconciler.validateGPUResource(context.TODO(), nimService, resources)).To(Succeed())
+		})
+
+		It("should skip the validation when no GPU resource is requested", func() {
+			Expect(reconciler.validateGPUResource(context.TODO(), nimService, nimService.Spec.Resources)).To(Succeed())
+		})
+	})
+
+	Describe("selectNIMCacheProfile", func() {
+		createGPUNode := func(name, product string, gpus string, unschedulable bool) {
+			Expect(client.Create(context.TODO(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"nvidia.com/gpu.product": product}},
+				Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{"nvidia.com/gpu": apiResource.MustParse(gpus)},
+				},
+			})).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			nimService.Spec.Storage.NIMCache = appsv1alpha1.NIMCacheVolSpec{Name: "test-nimcache", Profile: appsv1alpha1.AutoProfile}
+			nimCache.Status = appsv1alpha1.NIMCacheStatus{
+				State: appsv1alpha1.NimCacheStatusReady,
+				Profiles: []appsv1alpha1.NIMProfile{
+					{Name: "h100-trtllm-tp4", Config: map[string]string{"gpu": "H100", "llm_engine": "tensorrt_llm", "tp": "4"}},
+					{Name: "h100-trtllm-tp2", Config: map[string]string{"gpu": "H100", "llm_engine": "tensorrt_llm", "tp": "2"}},
+					{Name: "l40s-trtllm-tp1", Config: map[string]string{"gpu": "L40S", "llm_engine": "tensorrt_llm", "tp": "1"}},
+					{Name: "generic-vllm-tp1", Config: map[string]string{"llm_engine": "vllm", "tp": "1"}},
+				},
+			}
+			Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+		})
+
+		It("should select the optimized profile requiring the fewest GPUs that fits", func() {
+			createGPUNode("h100-node", "NVIDIA-H100-80GB-HBM3", "8", false)
+
+			profile, err := reconciler.selectNIMCacheProfile(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("h100-trtllm-tp2"))
+			Expect(nimService.Status.Profile.Name).To(Equal("h100-trtllm-tp2"))
+			Expect(nimService.Status.Profile.GPU).To(Equal("NVIDIA-H100-80GB-HBM3"))
+			Expect(nimService.Status.Profile.Reason).To(ContainSubstring("optimized for GPU NVIDIA-H100-80GB-HBM3"))
+		})
+
+		It("should skip GPUs on unschedulable nodes and nodes without enough GPUs", func() {
+			createGPUNode("h100-node", "NVIDIA-H100-80GB-HBM3", "8", true)
+			createGPUNode("l40s-node", "NVIDIA-L40S", "1", false)
+
+			profile, err := reconciler.selectNIMCacheProfile(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("l40s-trtllm-tp1"))
+		})
+
+		It("should fall back to a generic profile when no optimized profile fits", func() {
+			createGPUNode("a100-node", "NVIDIA-A100-SXM4-80GB", "8", false)
+
+			profile, err := reconciler.selectNIMCacheProfile(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("generic-vllm-tp1"))
+			Expect(nimService.Status.Profile.Reason).To(ContainSubstring("generic profile"))
+		})
+
+		It("should not select profiles for GPU products sharing a name prefix", func() {
+			nimCache.Status.Profiles = []appsv1alpha1.NIMProfile{
+				{Name: "a10-trtllm-tp1", Config: map[string]string{"gpu": "A10", "llm_engine": "tensorrt_llm", "tp": "1"}},
+				{Name: "l4-trtllm-tp1", Config: map[string]string{"gpu": "L4", "llm_engine": "tensorrt_llm", "tp": "1"}},
+				{Name: "generic-vllm-tp1", Config: map[string]string{"llm_engine": "vllm", "tp": "1"}},
+			}
+			Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+			createGPUNode("a100-node", "NVIDIA-A100-SXM4-80GB", "8", false)
+			createGPUNode("l40s-node", "NVIDIA-L40S", "1", false)
+
+			profile, err := reconciler.selectNIMCacheProfile(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("generic-vllm-tp1"))
+		})
+
+		It("should only select single GPU profiles for MIG devices", func() {
+			// The MIG slices of the node are not GPUs for the multi-GPU profiles
+			Expect(client.Create(context.TODO(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "h100-mig-node", Labels: map[string]string{"nvidia.com/gpu.product": "NVIDIA-H100-80GB-HBM3-MIG-1g.10gb"}},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{"nvidia.com/mig-1g.10gb": apiResource.MustParse("7")},
+				},
+			})).To(Succeed())
+			nimService.Spec.GPUResourceName = "nvidia.com/mig-1g.10gb"
+
+			profile, err := reconciler.selectNIMCacheProfile(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("generic-vllm-tp1"))
+			Expect(nimService.Status.Profile.Reason).To(ContainSubstring("with 1 GPUs per pod"))
+		})
+
+		It("should fail when no cached profile fits on the GPUs in the cluster", func() {
+			_, err := reconciler.selectNIMCacheProfile(context.TODO(), nimService)
+			Expect(err).To(HaveOccurred())
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Failed)).To(BeTrue())
+		})
+	})
+
+	Describe("assignNodeAffinity", func() {
+		var profile *appsv1alpha1.NIMProfile
+
+		createGPUNode := func(name, product string) {
+			Expect(client.Create(context.TODO(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"nvidia.com/gpu.product": product}},
+			})).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			profile = &appsv1alpha1.NIMProfile{
+				Name:   "test-profile",
+				Config: map[string]string{"gpu": "H100", "tp": "1"},
+			}
+		})
+
+		It("should require nodes with the GPU product of the profile", func() {
+			createGPUNode("h100-sxm-node", "NVIDIA-H100-80GB-HBM3")
+			createGPUNode("h100-pcie-node", "NVIDIA-H100-PCIe")
+			createGPUNode("l40s-node", "NVIDIA-L40S")
+
+			deploymentParams := nimService.GetDeploymentParams()
+			Expect(reconciler.assignNodeAffinity(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			terms := deploymentParams.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].MatchExpressions).To(Equal([]corev1.NodeSelectorRequirement{
+				{Key: "nvidia.com/gpu.product", Operator: corev1.NodeSelectorOpIn, Values: []string{"NVIDIA-H100-80GB-HBM3", "NVIDIA-H100-PCIe"}},
+			}))
+		})
+
+		It("should not match GPU products sharing a name prefix", func() {
+			createGPUNode("a10-node", "NVIDIA-A10")
+			createGPUNode("a100-node", "NVIDIA-A100-SXM4-80GB")
+			createGPUNode("l4-node", "NVIDIA-L4")
+			createGPUNode("l40s-node", "NVIDIA-L40S")
+
+			for gpu, products := range map[string][]string{
+				"A10":  {"NVIDIA-A10"},
+				"a100": {"NVIDIA-A100-SXM4-80GB"},
+				"L4":   {"NVIDIA-L4"},
+				"L40S": {"NVIDIA-L40S"},
+			} {
+				profile.Config["gpu"] = gpu
+				deploymentParams := nimService.GetDeploymentParams()
+				Expect(reconciler.assignNodeAffinity(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+				terms := deploymentParams.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+				Expect(terms[0].MatchExpressions[0].Values).To(Equal(products), "gpu %s", gpu)
+			}
+		})
+
+		It("should merge the GPU requirement into every existing node selector term", func() {
+			createGPUNode("h100-node", "NVIDIA-H100-80GB-HBM3")
+
+			nimService.Spec.Affinity = &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}}},
+							{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}}}},
+						},
+					},
+				},
+			}
+			deploymentParams := nimService.GetDeploymentParams()
+			Expect(reconciler.assignNodeAffinity(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			Expect(nimService.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(HaveLen(1))
+			for _, term := range deploymentParams.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+				Expect(term.MatchExpressions).To(HaveLen(2))
+				Expect(term.MatchExpressions[1].Key).To(Equal("nvidia.com/gpu.product"))
+			}
+		})
+
+		It("should fail when no node has the GPU product of the profile", func() {
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			createGPUNode("l40s-node", "NVIDIA-L40S")
+
+			err := reconciler.assignNodeAffinity(context.TODO(), nimService, profile, nimService.GetDeploymentParams())
+			Expect(err).To(MatchError("no nodes with GPU H100 required by profile test-profile"))
+			Expect(meta.IsStatusConditionTrue(nimService.Status.Conditions, conditions.Failed)).To(BeTrue())
+		})
+
+		It("should not restrict scheduling for generic profiles or clusters without GPU product labels", func() {
+			deploymentParams := nimService.GetDeploymentParams()
+			Expect(reconciler.assignNodeAffinity(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			Expect(deploymentParams.Affinity).To(BeNil())
+
+			createGPUNode("l40s-node", "NVIDIA-L40S")
+			delete(profile.Config, "gpu")
+			Expect(reconciler.assignNodeAffinity(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			Expect(deploymentParams.Affinity).To(BeNil())
+		})
+	})
+
+	Describe("reconcileResourceClaims", func() {
+		var profile *appsv1alpha1.NIMProfile
+
+		BeforeEach(func() {
+			profile = &appsv1alpha1.NIMProfile{
+				Name:   "test-profile",
+				Config: map[string]string{"tp": "2", "gpu": "H100"},
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+		})
+
+		It("should generate a resource claim template from the profile", func() {
+			nimService.Spec.DRA = &appsv1alpha1.DRAResources{}
+			deploymentParams := nimService.GetDeploymentParams()
+			Expect(reconciler.reconcileResourceClaims(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+
+			template := &resourcev1alpha3.ResourceClaimTemplate{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.GetName() + "-gpu", Namespace: nimService.GetNamespace()}, template)).To(Succeed())
+			requests := template.Spec.Spec.Devices.Requests
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].DeviceClassName).To(Equal("gpu.nvidia.com"))
+			Expect(requests[0].Count).To(Equal(int64(2)))
+			Expect(requests[0].Selectors).To(HaveLen(1))
+			Expect(requests[0].Selectors[0].CEL.Expression).To(Equal(
+				`device.attributes["gpu.nvidia.com"].productName.matches("(?i)(^|[^[:alnum:]])H100([^[:alnum:]]|$)")`))
+			// The product name matches the whole tokens of the profile GPU
+			productName := regexp.MustCompile(`(?i)(^|[^[:alnum:]])H100([^[:alnum:]]|$)`)
+			Expect(productName.MatchString("NVIDIA H100 80GB HBM3")).To(BeTrue())
+			Expect(productName.MatchString("NVIDIA-H100-PCIe")).To(BeTrue())
+			Expect(productName.MatchString("NVIDIA H1000")).To(BeFalse())
+
+			Expect(deploymentParams.ResourceClaims).To(HaveLen(1))
+			Expect(*deploymentParams.ResourceClaims[0].ResourceClaimTemplateName).To(Equal(nimService.GetName() + "-gpu"))
+			Expect(deploymentParams.Resources.Claims).To(ContainElement(corev1.ResourceClaim{Name: "gpu"}))
+			Expect(nimService.Spec.Resources.Claims).To(BeEmpty())
+		})
+
+		It("should replace the generated template when the requested GPUs change", func() {
+			nimService.Spec.DRA = &appsv1alpha1.DRAResources{}
+			Expect(reconciler.reconcileResourceClaims(context.TODO(), nimService, profile, nimService.GetDeploymentParams())).To(Succeed())
+
+			nimService.Spec.DRA.Count = ptr.To[int64](4)
+			Expect(reconciler.reconcileResourceClaims(context.TODO(), nimService, profile, nimService.GetDeploymentParams())).To(Succeed())
+
+			template := &resourcev1alpha3.ResourceClaimTemplate{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.GetName() + "-gpu", Namespace: nimService.GetNamespace()}, template)).To(Succeed())
+			Expect(template.Spec.Spec.Devices.Requests[0].Count).To(Equal(int64(4)))
+		})
+
+		It("should reference an existing template and remove the generated one", func() {
+			nimService.Spec.DRA = &appsv1alpha1.DRAResources{}
+			Expect(reconciler.reconcileResourceClaims(context.TODO(), nimService, profile, nimService.GetDeploymentParams())).To(Succeed())
+
+			nimService.Spec.DRA.ResourceClaimTemplateName = "shared-gpus"
+			deploymentParams := nimService.GetDeploymentParams()
+			Expect(reconciler.reconcileResourceClaims(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			Expect(*deploymentParams.ResourceClaims[0].ResourceClaimTemplateName).To(Equal("shared-gpus"))
+
+			template := &resourcev1alpha3.ResourceClaimTemplate{}
+			err := client.Get(context.TODO(), types.NamespacedName{Name: nimService.GetName() + "-gpu", Namespace: nimService.GetNamespace()}, template)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not add resource claims when DRA is disabled", func() {
+			deploymentParams := nimService.GetDeploymentParams()
+			Expect(reconciler.reconcileResourceClaims(context.TODO(), nimService, profile, deploymentParams)).To(Succeed())
+			Expect(deploymentParams.ResourceClaims).To(BeEmpty())
+		})
+	})
 })
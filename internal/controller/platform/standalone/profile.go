@@ -0,0 +1,242 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
+	corev1 "k8s.io/api/core/v1"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// profileGPUKey is the profile config key of the GPU product the profile is optimized for
+	profileGPUKey = "gpu"
+	// profileEngineKey is the profile config key of the inference engine of the profile
+	profileEngineKey = "llm_engine"
+	// tensorRTLLMEngine is the engine of profiles optimized for a specific GPU
+	tensorRTLLMEngine = "tensorrt_llm"
+	// gpuCountLabel is the node label with the number of GPUs published by GPU feature discovery
+	gpuCountLabel = "nvidia.com/gpu.count"
+)
+
+// profileCandidate is a cached profile that fits on the GPUs of at least one node
+type profileCandidate struct {
+	profile   appsv1alpha1.NIMProfile
+	gpu       string
+	gpus      int64
+	optimized bool
+}
+
+// selectNIMCacheProfile selects the best cached profile of the NIMCache for the GPUs available in the cluster.
+//
+// Profiles optimized for a GPU product found on schedulable nodes are preferred over generic profiles, TensorRT-LLM
+// profiles over other engines, and profiles requiring fewer GPUs per pod over larger ones. A profile fits when some
+// schedulable node with a matching GPU product has at least as many GPUs as the profile requires per pod.
+// The selection is recorded in the NIMService status.
+func (r *NIMServiceReconciler) selectNIMCacheProfile(ctx context.Context, nimService *appsv1alpha1.NIMService) (*appsv1alpha1.NIMProfile, error) {
+	logger := log.FromContext(ctx)
+
+	nimCache := &appsv1alpha1.NIMCache{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetNIMCacheName(), Namespace: nimService.Namespace}, nimCache); err != nil {
+		logger.Error(err, "unable to fetch nimcache", "nimcache", nimService.GetNIMCacheName(), "nimservice", nimService.Name)
+		return nil, err
+	}
+	if nimCache.Status.State != appsv1alpha1.NimCacheStatusReady {
+		return nil, fmt.Errorf("nimcache %s is not ready, nimservice %s", nimCache.GetName(), nimService.GetName())
+	}
+
+	gpusByProduct, err := r.getSchedulableGPUsByProduct(ctx, nimService)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []profileCandidate
+	for _, profile := range nimCache.Status.Profiles {
+		candidate, fits, err := r.fitProfile(ctx, nimService, profile, gpusByProduct)
+		if err != nil {
+			return nil, err
+		}
+		if fits {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	if len(candidates) == 0 {
+		err = fmt.Errorf("none of the %d profiles cached by nimcache %s fit on the GPUs available in the cluster", len(nimCache.Status.Profiles), nimCache.GetName())
+		r.GetEventRecorder().Event(nimService, corev1.EventTypeWarning, conditions.ReasonNoCompatibleProfile, err.Error())
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonNoCompatibleProfile, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return nil, err
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.optimized != b.optimized {
+			return a.optimized
+		}
+		if aTRT, bTRT := a.profile.Config[profileEngineKey] == tensorRTLLMEngine, b.profile.Config[profileEngineKey] == tensorRTLLMEngine; aTRT != bTRT {
+			return aTRT
+		}
+		if a.gpus != b.gpus {
+			return a.gpus < b.gpus
+		}
+		return a.profile.Name < b.profile.Name
+	})
+	selected := candidates[0]
+
+	var reason string
+	if selected.optimized {
+		reason = fmt.Sprintf("optimized for GPU %s with %d GPUs per pod", selected.gpu, selected.gpus)
+	} else {
+		reason = fmt.Sprintf("no cached profile is optimized for the GPUs available in the cluster, generic profile with %d GPUs per pod", selected.gpus)
+	}
+
+	if nimService.Status.Profile == nil || nimService.Status.Profile.Name != selected.profile.Name {
+		logger.Info("selected model profile", "profile", selected.profile.Name, "reason", reason)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.ReasonProfileSelected, "Selected profile %s, %s", selected.profile.Name, reason)
+	}
+	nimService.Status.Profile = &appsv1alpha1.ProfileSelectionStatus{
+		Name:   selected.profile.Name,
+		GPU:    selected.gpu,
+		Reason: reason,
+	}
+	return &selected.profile, nil
+}
+
+// fitProfile returns the profile as a candidate if some GPU product has enough GPUs on a node for the profile
+func (r *NIMServiceReconciler) fitProfile(ctx context.Context, nimService *appsv1alpha1.NIMService, profile appsv1alpha1.NIMProfile, gpusByProduct map[string]int64) (profileCandidate, bool, error) {
+	candidate := profileCandidate{profile: profile, gpus: 1}
+
+	tensorParallelism, err := r.getTensorParallelismByProfile(ctx, &profile)
+	if err != nil {
+		return candidate, false, err
+	}
+	gpus := apiResource.MustParse("1")
+	if tensorParallelism != "" {
+		gpus, err = apiResource.ParseQuantity(tensorParallelism)
+		if err != nil {
+			return candidate, false, fmt.Errorf("failed to parse tensorParallelism of profile %s: %w", profile.Name, err)
+		}
+	}
+	if nimService.IsMultiNodeEnabled() {
+		gpus, err = r.getMultiNodeGPUsPerPod(ctx, nimService, &profile, gpus)
+		if err != nil {
+			return candidate, false, err
+		}
+	}
+	candidate.gpus = gpus.Value()
+
+	gpu := strings.TrimSpace(profile.Config[profileGPUKey])
+	candidate.optimized = gpu != ""
+
+	// Pick the matching product with the most GPUs per node for a stable selection
+	products := make([]string, 0, len(gpusByProduct))
+	for product := range gpusByProduct {
+		products = append(products, product)
+	}
+	sort.Strings(products)
+
+	// MIG slices are counted as GPUs on the node but a container is only assigned a single MIG device
+	available := func(product string) int64 {
+		if nimService.IsMIGResource() {
+			return min(gpusByProduct[product], 1)
+		}
+		return gpusByProduct[product]
+	}
+
+	fits := false
+	for _, product := range products {
+		if gpu != "" && !matchGPUProduct(product, gpu) {
+			continue
+		}
+		if available(product) >= candidate.gpus && (!fits || available(product) > available(candidate.gpu)) {
+			candidate.gpu = product
+			fits = true
+		}
+	}
+	return candidate, fits, nil
+}
+
+// matchGPUProduct returns whether the GPU product label of a node is the GPU of a profile.
+//
+// Both names are split into upper case alphanumeric tokens and the tokens of the profile GPU must appear in sequence
+// in the product, so that "H100" matches "NVIDIA-H100-80GB-HBM3" while "A10" does not match "NVIDIA-A100-SXM4-80GB".
+func matchGPUProduct(product, gpu string) bool {
+	productTokens := shared.GPUNameTokens(product)
+	gpuTokens := shared.GPUNameTokens(gpu)
+	if len(gpuTokens) == 0 {
+		return false
+	}
+	for i := 0; i+len(gpuTokens) <= len(productTokens); i++ {
+		if slices.Equal(productTokens[i:i+len(gpuTokens)], gpuTokens) {
+			return true
+		}
+	}
+	return false
+}
+
+// getSchedulableGPUsByProduct returns the largest number of GPUs on a single schedulable node for each GPU product.
+//
+// GPUs on nodes without a GPU product label are reported for an empty product and only fit generic profiles.
+func (r *NIMServiceReconciler) getSchedulableGPUsByProduct(ctx context.Context, nimService *appsv1alpha1.NIMService) (map[string]int64, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.GetClient().List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("unable to list nodes: %w", err)
+	}
+
+	gpuResourceName := nimService.GetGPUResourceName()
+	gpusByProduct := map[string]int64{}
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+
+		var gpus int64
+		if nimService.IsDRAEnabled() {
+			// DRA devices are not advertised as allocatable resources
+			count, err := apiResource.ParseQuantity(node.Labels[gpuCountLabel])
+			if err != nil {
+				continue
+			}
+			gpus = count.Value()
+		} else if quantity, ok := node.Status.Allocatable[gpuResourceName]; ok {
+			gpus = quantity.Value()
+		}
+		if gpus == 0 {
+			continue
+		}
+
+		product := strings.TrimSpace(node.Labels[k8sutil.GPUProductLabel])
+		if gpus > gpusByProduct[product] {
+			gpusByProduct[product] = gpus
+		}
+	}
+	return gpusByProduct, nil
+}
@@ -0,0 +1,452 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// rolloutRequeueInterval is the interval to poll the candidate revision during a rollout
+const rolloutRequeueInterval = 30 * time.Second
+
+// runSmokeTest sends the smoke test request to the given endpoint with the given client, replaced in tests
+var runSmokeTest = func(ctx context.Context, httpClient *http.Client, endpoint string, smokeTest *appsv1alpha1.SmokeTest) error {
+	timeout := time.Duration(smokeTest.TimeoutSeconds) * time.Second
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	path := smokeTest.Path
+	if path == "" {
+		path = "/v1/models"
+	}
+	method := http.MethodGet
+	var body io.Reader
+	if smokeTest.Body != "" {
+		method = http.MethodPost
+		body = bytes.NewBufferString(smokeTest.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("smoke test request %s %s returned status %d", method, path, resp.StatusCode)
+	}
+	return nil
+}
+
+// getPodTemplateRevision returns a short hash identifying the pod template of the given deployment
+func getPodTemplateRevision(deployment *appsv1.Deployment) string {
+	return utils.GetResourceHash(&appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: deployment.Spec.Template}})[:10]
+}
+
+// getCanaryReplicas returns the replicas of the candidate revision to receive the given percentage of traffic,
+// steps are validated to at most 50%
+func getCanaryReplicas(stableReplicas int32, weight int32) int32 {
+	if weight >= 50 {
+		return stableReplicas
+	}
+	// Stable replicas are retained, canary replicas are added on top to avoid dropping capacity
+	replicas := (stableReplicas*weight + (100 - weight) - 1) / (100 - weight)
+	if replicas < 1 {
+		replicas = 1
+	}
+	return replicas
+}
+
+// cleanupRollout deletes the candidate revision and resets the rollout status
+func (r *NIMServiceReconciler) cleanupRollout(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	candidateName := types.NamespacedName{Name: nimService.GetCandidateName(), Namespace: nimService.GetNamespace()}
+	if err := r.cleanupResource(ctx, &appsv1.Deployment{}, candidateName); err != nil {
+		return err
+	}
+	if err := r.cleanupResource(ctx, &corev1.Service{}, candidateName); err != nil {
+		return err
+	}
+	if !nimService.IsRolloutEnabled() {
+		nimService.Status.Rollout = nil
+	}
+	return nil
+}
+
+// syncOwnedResource syncs a resource that is not named after the NIMService instance
+func (r *NIMServiceReconciler) syncOwnedResource(ctx context.Context, nimService *appsv1alpha1.NIMService, obj client.Object, desired client.Object) error {
+	if err := controllerutil.SetControllerReference(nimService, desired, r.GetScheme()); err != nil {
+		return err
+	}
+	return r.syncResource(ctx, obj, desired, types.NamespacedName{Name: desired.GetName(), Namespace: desired.GetNamespace()})
+}
+
+// setRolloutTraffic routes traffic to the given revisions by updating the NIMService service selector
+func (r *NIMServiceReconciler) setRolloutTraffic(ctx context.Context, nimService *appsv1alpha1.NIMService, traffic string) error {
+	if nimService.GetRolloutTraffic() == traffic {
+		return nil
+	}
+	nimService.Status.Rollout.Traffic = traffic
+
+	service, err := r.renderer.Service(nimService.GetServiceParams())
+	if err != nil {
+		return err
+	}
+	return r.syncOwnedResource(ctx, nimService, &corev1.Service{}, service)
+}
+
+// setRolloutPhase transitions the rollout to the given phase
+func setRolloutPhase(nimService *appsv1alpha1.NIMService, phase string, message string) {
+	now := metav1.Now()
+	nimService.Status.Rollout.Phase = phase
+	nimService.Status.Rollout.StepStartTime = &now
+	nimService.Status.Rollout.Message = message
+}
+
+// reconcileRollout deploys new revisions of the NIMService next to the stable deployment and promotes them once validated.
+//
+// The stable revision is always served by the NIMService deployment, which keeps any HPA targeting it valid.
+// A new revision is deployed as a candidate deployment, receives traffic either in weighted steps (Canary) or
+// all at once (BlueGreen) after passing readiness and the optional smoke test, and is then promoted by updating
+// the stable deployment. Candidates that fail are removed and their revision is not retried until the spec changes.
+func (r *NIMServiceReconciler) reconcileRollout(ctx context.Context, nimService *appsv1alpha1.NIMService, deploymentParams *rendertypes.DeploymentParams) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+
+	// Env variables are merged from maps, sort them for a stable revision
+	sort.SliceStable(deploymentParams.Env, func(i, j int) bool {
+		return deploymentParams.Env[i].Name < deploymentParams.Env[j].Name
+	})
+	desired, err := r.renderer.Deployment(deploymentParams)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	desiredRevision := getPodTemplateRevision(desired)
+
+	stable := &appsv1.Deployment{}
+	err = r.Get(ctx, namespacedName, stable)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	rollout := nimService.Status.Rollout
+	if errors.IsNotFound(err) || rollout == nil || rollout.StableRevision == "" {
+		// Nothing to roll out from, deploy or adopt the stable deployment in place
+		nimService.Status.Rollout = &appsv1alpha1.RolloutStatus{
+			Phase:          appsv1alpha1.RolloutPhaseStable,
+			StableRevision: desiredRevision,
+			Traffic:        appsv1alpha1.RolloutTrafficStable,
+		}
+		return r.syncStableRevision(ctx, nimService, deploymentParams)
+	}
+
+	switch desiredRevision {
+	case rollout.StableRevision:
+		// Spec matches the stable revision, remove any candidate left from an aborted rollout
+		if rollout.CandidateRevision != "" {
+			logger.Info("Aborting rollout, spec reverted to the stable revision", "revision", rollout.CandidateRevision)
+			if err := r.setRolloutTraffic(ctx, nimService, appsv1alpha1.RolloutTrafficStable); err != nil {
+				return ctrl.Result{}, err
+			}
+			if err := r.cleanupRollout(ctx, nimService); err != nil {
+				return ctrl.Result{}, err
+			}
+			rollout.CandidateRevision = ""
+			setRolloutPhase(nimService, appsv1alpha1.RolloutPhaseStable, "rollout aborted, spec reverted to the stable revision")
+		}
+		return r.syncStableRevision(ctx, nimService, deploymentParams)
+	case rollout.FailedRevision:
+		// Keep serving the stable revision until the spec changes
+		msg := fmt.Sprintf("revision %s failed and was rolled back, update the spec to retry", desiredRevision)
+		return r.updateRolloutStatus(ctx, nimService, namespacedName, msg)
+	}
+
+	if rollout.CandidateRevision != desiredRevision {
+		// Start a new rollout, replacing any in-progress candidate
+		logger.Info("Starting rollout of new revision", "strategy", nimService.GetRolloutStrategy(), "stable", rollout.StableRevision, "candidate", desiredRevision)
+		rollout.CandidateRevision = desiredRevision
+		rollout.CurrentStep = 0
+		rollout.CanaryWeight = 0
+		rollout.SmokeTestPassed = false
+		if err := r.setRolloutTraffic(ctx, nimService, appsv1alpha1.RolloutTrafficStable); err != nil {
+			return ctrl.Result{}, err
+		}
+		setRolloutPhase(nimService, appsv1alpha1.RolloutPhaseProgressing, fmt.Sprintf("rolling out revision %s", desiredRevision))
+	}
+
+	if rollout.Phase == appsv1alpha1.RolloutPhasePromoting {
+		return r.promoteRollout(ctx, nimService, deploymentParams)
+	}
+	if rollout.Phase == appsv1alpha1.RolloutPhaseFailed {
+		return r.updateRolloutStatus(ctx, nimService, namespacedName, rollout.Message)
+	}
+
+	// Sync the candidate deployment
+	stableReplicas := int32(nimService.GetReplicas())
+	if stable.Spec.Replicas != nil {
+		stableReplicas = *stable.Spec.Replicas
+	}
+	candidateReplicas := stableReplicas
+	if nimService.GetRolloutStrategy() == appsv1alpha1.RolloutStrategyCanary {
+		weight := nimService.GetRolloutSteps()[rollout.CurrentStep]
+		candidateReplicas = getCanaryReplicas(stableReplicas, weight)
+	}
+	if candidateReplicas < 1 {
+		candidateReplicas = 1
+	}
+	candidateParams := *deploymentParams
+	candidateParams.Name = nimService.GetCandidateName()
+	candidateParams.Replicas = int(candidateReplicas)
+	candidate, err := r.renderer.Deployment(&candidateParams)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.syncOwnedResource(ctx, nimService, &appsv1.Deployment{}, candidate); err != nil {
+		logger.Error(err, "failed to sync", "deployment", candidate.GetName())
+		return ctrl.Result{}, err
+	}
+
+	// Sync the candidate service, used to validate the candidate before it receives traffic
+	serviceParams := nimService.GetServiceParams()
+	serviceParams.Name = nimService.GetCandidateName()
+	serviceParams.Type = string(corev1.ServiceTypeClusterIP)
+	serviceParams.SelectorLabels = map[string]string{"app": nimService.GetCandidateName()}
+	candidateService, err := r.renderer.Service(serviceParams)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.syncOwnedResource(ctx, nimService, &corev1.Service{}, candidateService); err != nil {
+		logger.Error(err, "failed to sync", "service", candidateService.GetName())
+		return ctrl.Result{}, err
+	}
+
+	// Wait for the candidate to become ready within the progress deadline
+	candidateName := types.NamespacedName{Name: nimService.GetCandidateName(), Namespace: nimService.GetNamespace()}
+	msg, ready, err := r.isDeploymentReady(ctx, &candidateName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !ready {
+		if rollout.StepStartTime != nil && time.Since(rollout.StepStartTime.Time) > nimService.GetRolloutProgressDeadline() {
+			return r.failRollout(ctx, nimService, fmt.Sprintf("revision %s did not become ready within %s", desiredRevision, nimService.GetRolloutProgressDeadline()))
+		}
+		rollout.Message = fmt.Sprintf("waiting for revision %s: %s", desiredRevision, strings.TrimSpace(msg))
+		return r.updateRolloutStatus(ctx, nimService, namespacedName, rollout.Message)
+	}
+
+	// Validate the candidate before it receives traffic
+	if smokeTest := nimService.Spec.Rollout.SmokeTest; smokeTest != nil && !rollout.SmokeTestPassed {
+		scheme := "http"
+		httpClient := http.DefaultClient
+		if nimService.IsTLSEnabled() {
+			scheme = "https"
+			httpClient, err = r.getServingHTTPClient(ctx, nimService)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		endpoint := fmt.Sprintf("%s://%s.%s.svc:%d", scheme, candidateName.Name, candidateName.Namespace, nimService.GetServicePort())
+		if err := runSmokeTest(ctx, httpClient, endpoint, smokeTest); err != nil {
+			return r.failRollout(ctx, nimService, fmt.Sprintf("revision %s failed the smoke test: %v", desiredRevision, err))
+		}
+		rollout.SmokeTestPassed = true
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, "SmokeTestPassed",
+			"NIMService %s revision %s passed the smoke test", nimService.Name, desiredRevision)
+	}
+
+	if nimService.GetRolloutStrategy() == appsv1alpha1.RolloutStrategyBlueGreen {
+		// Switch all traffic to the candidate and update the stable deployment behind it
+		if err := r.setRolloutTraffic(ctx, nimService, appsv1alpha1.RolloutTrafficCandidate); err != nil {
+			return ctrl.Result{}, err
+		}
+		setRolloutPhase(nimService, appsv1alpha1.RolloutPhasePromoting, fmt.Sprintf("promoting revision %s", desiredRevision))
+		return r.promoteRollout(ctx, nimService, deploymentParams)
+	}
+
+	// Shift traffic to the candidate in steps
+	steps := nimService.GetRolloutSteps()
+	if err := r.setRolloutTraffic(ctx, nimService, appsv1alpha1.RolloutTrafficSplit); err != nil {
+		return ctrl.Result{}, err
+	}
+	if rollout.CanaryWeight != steps[rollout.CurrentStep] {
+		rollout.CanaryWeight = steps[rollout.CurrentStep]
+		setRolloutPhase(nimService, appsv1alpha1.RolloutPhaseProgressing, fmt.Sprintf("shifted %d%% of traffic to revision %s", rollout.CanaryWeight, desiredRevision))
+	}
+	if rollout.StepStartTime != nil && time.Since(rollout.StepStartTime.Time) < nimService.GetRolloutStepInterval() {
+		return r.updateRolloutStatus(ctx, nimService, namespacedName, rollout.Message)
+	}
+	if int(rollout.CurrentStep) < len(steps)-1 {
+		rollout.CurrentStep++
+		return r.updateRolloutStatus(ctx, nimService, namespacedName, rollout.Message)
+	}
+
+	setRolloutPhase(nimService, appsv1alpha1.RolloutPhasePromoting, fmt.Sprintf("promoting revision %s", desiredRevision))
+	return r.promoteRollout(ctx, nimService, deploymentParams)
+}
+
+// promoteRollout updates the stable deployment to the candidate revision and removes the candidate once it is ready
+func (r *NIMServiceReconciler) promoteRollout(ctx context.Context, nimService *appsv1alpha1.NIMService, deploymentParams *rendertypes.DeploymentParams) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+	rollout := nimService.Status.Rollout
+
+	renderer := r.GetRenderer()
+	err := r.renderAndSyncResource(ctx, nimService, &renderer, &appsv1.Deployment{}, func() (client.Object, error) {
+		return renderer.Deployment(deploymentParams)
+	}, "deployment", conditions.ReasonDeploymentFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	stable := &appsv1.Deployment{}
+	if err := r.Get(ctx, namespacedName, stable); err != nil {
+		return ctrl.Result{}, err
+	}
+	msg, ready, err := r.isDeploymentReady(ctx, &namespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if stable.Status.ObservedGeneration < stable.Generation || !ready {
+		rollout.Message = fmt.Sprintf("promoting revision %s: %s", rollout.CandidateRevision, strings.TrimSpace(msg))
+		return r.updateRolloutStatus(ctx, nimService, namespacedName, rollout.Message)
+	}
+
+	// Stable deployment serves the new revision, remove the candidate
+	if err := r.setRolloutTraffic(ctx, nimService, appsv1alpha1.RolloutTrafficStable); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.cleanupRollout(ctx, nimService); err != nil {
+		return ctrl.Result{}, err
+	}
+	logger.Info("Promoted revision", "revision", rollout.CandidateRevision)
+	r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, "RolloutSucceeded",
+		"NIMService %s revision %s promoted", nimService.Name, rollout.CandidateRevision)
+	rollout.StableRevision = rollout.CandidateRevision
+	rollout.CandidateRevision = ""
+	rollout.CanaryWeight = 0
+	rollout.CurrentStep = 0
+	rollout.SmokeTestPassed = false
+	setRolloutPhase(nimService, appsv1alpha1.RolloutPhaseStable, fmt.Sprintf("revision %s promoted", rollout.StableRevision))
+	return r.updateRolloutStatus(ctx, nimService, namespacedName, rollout.Message)
+}
+
+// failRollout routes traffic back to the stable revision and removes the candidate when automatic rollback is enabled
+func (r *NIMServiceReconciler) failRollout(ctx context.Context, nimService *appsv1alpha1.NIMService, reason string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+	rollout := nimService.Status.Rollout
+
+	if err := r.setRolloutTraffic(ctx, nimService, appsv1alpha1.RolloutTrafficStable); err != nil {
+		return ctrl.Result{}, err
+	}
+	rollout.CanaryWeight = 0
+	r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, "RolloutFailed", "NIMService %s %s", nimService.Name, reason)
+
+	if !nimService.IsRolloutAutoRollbackEnabled() {
+		logger.Info("Rollout failed, keeping the candidate for troubleshooting", "reason", reason)
+		setRolloutPhase(nimService, appsv1alpha1.RolloutPhaseFailed, reason)
+		return r.updateRolloutStatus(ctx, nimService, namespacedName, reason)
+	}
+
+	logger.Info("Rollout failed, rolling back", "reason", reason)
+	if err := r.cleanupRollout(ctx, nimService); err != nil {
+		return ctrl.Result{}, err
+	}
+	rollout.FailedRevision = rollout.CandidateRevision
+	rollout.CandidateRevision = ""
+	rollout.CurrentStep = 0
+	rollout.SmokeTestPassed = false
+	setRolloutPhase(nimService, appsv1alpha1.RolloutPhaseRolledBack, reason)
+	return r.updateRolloutStatus(ctx, nimService, namespacedName, reason)
+}
+
+// syncStableRevision syncs the stable deployment in place and updates the NIMService status from its readiness
+func (r *NIMServiceReconciler) syncStableRevision(ctx context.Context, nimService *appsv1alpha1.NIMService, deploymentParams *rendertypes.DeploymentParams) (ctrl.Result, error) {
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+	renderer := r.GetRenderer()
+	err := r.renderAndSyncResource(ctx, nimService, &renderer, &appsv1.Deployment{}, func() (client.Object, error) {
+		return renderer.Deployment(deploymentParams)
+	}, "deployment", conditions.ReasonDeploymentFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.updateRolloutStatus(ctx, nimService, namespacedName, "")
+}
+
+// updateRolloutStatus updates the NIMService status from the readiness of the stable deployment and the rollout progress
+func (r *NIMServiceReconciler) updateRolloutStatus(ctx context.Context, nimService *appsv1alpha1.NIMService, namespacedName types.NamespacedName, rolloutMsg string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	msg, ready, err := r.isDeploymentReady(ctx, &namespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if rolloutMsg != "" {
+		msg = fmt.Sprintf("%s, rollout: %s", strings.TrimSpace(msg), rolloutMsg)
+	}
+
+	if !ready {
+		// Update status as NotReady
+		err = r.updater.SetConditionsNotReady(ctx, nimService, conditions.NotReady, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.NotReady,
+			"NIMService %s not ready yet, msg: %s", nimService.Name, msg)
+	} else {
+		// Update status as ready
+		err = r.updater.SetConditionsReady(ctx, nimService, conditions.Ready, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.Ready,
+			"NIMService %s ready, msg: %s", nimService.Name, msg)
+	}
+	if err != nil {
+		logger.Error(err, "Unable to update status")
+		return ctrl.Result{}, err
+	}
+
+	// Rollout steps are time based, poll until the rollout completes
+	if phase := nimService.Status.Rollout.Phase; phase == appsv1alpha1.RolloutPhaseProgressing || phase == appsv1alpha1.RolloutPhasePromoting {
+		return ctrl.Result{RequeueAfter: rolloutRequeueInterval}, nil
+	}
+	return ctrl.Result{}, nil
+}
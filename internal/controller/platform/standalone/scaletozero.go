@@ -0,0 +1,309 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// idleCheckInterval is the interval to check for requests to a NIMService suspended when idle
+const idleCheckInterval = time.Minute
+
+// queryPrometheus returns the value of the given instant query, replaced in tests
+var queryPrometheus = func(ctx context.Context, prometheusURL string, query string) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	endpoint := fmt.Sprintf("%s/api/v1/query?query=%s", strings.TrimSuffix(prometheusURL, "/"), url.QueryEscape(query))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			Result []struct {
+				Value []interface{} `json:"value"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to decode prometheus response: %w", err)
+	}
+	if result.Status != "success" {
+		return 0, fmt.Errorf("prometheus query failed: %s", result.Error)
+	}
+	// No samples means no requests were recorded
+	if len(result.Data.Result) == 0 || len(result.Data.Result[0].Value) != 2 {
+		return 0, nil
+	}
+	value, ok := result.Data.Result[0].Value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected prometheus sample value %v", result.Data.Result[0].Value[1])
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// reconcileSuspension updates the suspension state of the NIMService and returns the interval to check for requests
+func (r *NIMServiceReconciler) reconcileSuspension(ctx context.Context, nimService *appsv1alpha1.NIMService) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+
+	if !nimService.Spec.Suspend && !nimService.IsScaleToZeroEnabled() {
+		nimService.Status.Suspension = nil
+		return 0, r.cleanupActivator(ctx, nimService)
+	}
+
+	now := metav1.Now()
+	if nimService.Status.Suspension == nil {
+		nimService.Status.Suspension = &appsv1alpha1.SuspensionStatus{LastActivityTime: &now}
+	}
+	suspension := nimService.Status.Suspension
+	if nimService.Spec.Suspend {
+		if !suspension.Suspended || suspension.Reason != appsv1alpha1.SuspendReasonManual {
+			logger.Info("Suspending NIMService")
+			*suspension = appsv1alpha1.SuspensionStatus{Suspended: true, Reason: appsv1alpha1.SuspendReasonManual, SuspendedAt: &now}
+		}
+	} else if suspension.Suspended && suspension.Reason == appsv1alpha1.SuspendReasonManual {
+		logger.Info("Resuming NIMService")
+		*suspension = appsv1alpha1.SuspensionStatus{LastActivityTime: &now}
+	}
+
+	if !nimService.IsScaleToZeroEnabled() {
+		return 0, r.cleanupActivator(ctx, nimService)
+	}
+	// Keep the activator running to serve requests as soon as the NIMService is suspended
+	if err := r.syncActivator(ctx, nimService); err != nil {
+		return 0, err
+	}
+	if nimService.Spec.Suspend {
+		return 0, nil
+	}
+
+	if suspension.Suspended {
+		// Resume on requests received by the activator after the suspension
+		wakeRequested, err := time.Parse(time.RFC3339, nimService.GetAnnotations()[appsv1alpha1.NIMServiceWakeAnnotationKey])
+		if err == nil && suspension.SuspendedAt != nil && wakeRequested.After(suspension.SuspendedAt.Time) {
+			logger.Info("Resuming idle NIMService on request", "requested", wakeRequested)
+			r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, "Resumed",
+				"NIMService %s resumed on request", nimService.Name)
+			*suspension = appsv1alpha1.SuspensionStatus{LastActivityTime: &now}
+		}
+		// Poll for wake requests in case the annotation update was missed
+		return idleCheckInterval, nil
+	}
+
+	// Suspend after a period without requests
+	idleTimeout := nimService.GetIdleTimeout()
+	query := fmt.Sprintf(`sum(increase(%s{namespace=%q,pod=~%q}[%ds]))`,
+		nimService.GetRequestMetric(), nimService.GetNamespace(), nimService.GetPodNameRegex(), int64(idleTimeout.Seconds()))
+	requests, err := queryPrometheus(ctx, nimService.Spec.ScaleToZero.PrometheusURL, query)
+	if err != nil {
+		// Never suspend on missing metrics
+		logger.Error(err, "unable to query NIMService requests", "query", query)
+		return idleCheckInterval, nil
+	}
+	if requests > 0 {
+		suspension.LastActivityTime = &now
+	} else if suspension.LastActivityTime == nil || time.Since(suspension.LastActivityTime.Time) >= idleTimeout {
+		logger.Info("Suspending idle NIMService", "idleTimeout", idleTimeout)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, "Suspended",
+			"NIMService %s suspended after %s without requests", nimService.Name, idleTimeout)
+		*suspension = appsv1alpha1.SuspensionStatus{Suspended: true, Reason: appsv1alpha1.SuspendReasonIdle, SuspendedAt: &now, LastActivityTime: suspension.LastActivityTime}
+	}
+	return idleCheckInterval, nil
+}
+
+// syncActivator syncs the activator resuming the NIMService on request
+func (r *NIMServiceReconciler) syncActivator(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	name := nimService.GetActivatorName()
+	namespace := nimService.GetNamespace()
+	labels := map[string]string{
+		"app":                          name,
+		"app.kubernetes.io/name":       nimService.GetName(),
+		"app.kubernetes.io/component":  "activator",
+		"app.kubernetes.io/managed-by": "k8s-nim-operator",
+	}
+
+	image := nimService.Spec.ScaleToZero.Activator.Image
+	if image == "" {
+		image = os.Getenv("OPERATOR_IMAGE")
+	}
+	if image == "" {
+		return fmt.Errorf("activator image is not set for NIMService %s", nimService.GetName())
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+	}
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{appsv1alpha1.SchemeGroupVersion.Group},
+				Resources:     []string{"nimservices"},
+				ResourceNames: []string{nimService.GetName()},
+				Verbs:         []string{"get", "patch"},
+			},
+		},
+	}
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      name,
+				Namespace: namespace,
+			},
+		},
+	}
+
+	port := nimService.GetServicePort()
+	scheme := "http"
+	args := []string{
+		fmt.Sprintf("--activator-nimservice=%s/%s", namespace, nimService.GetName()),
+		fmt.Sprintf("--activator-bind-address=:%d", port),
+	}
+	// Serve and forward the requests with the serving certificate of the NIMService
+	if nimService.IsTLSEnabled() {
+		scheme = "https"
+		args = append(args, fmt.Sprintf("--activator-tls-dir=%s", appsv1alpha1.TLSCertsPath))
+	}
+	args = append(args,
+		fmt.Sprintf("--activator-target=%s://%s.%s.svc:%d", scheme, nimService.GetName(), namespace, port),
+		fmt.Sprintf("--activator-wake-timeout=%s", nimService.GetWakeTimeout()),
+	)
+	var imagePullSecrets []corev1.LocalObjectReference
+	for _, secret := range nimService.Spec.ScaleToZero.Activator.PullSecrets {
+		imagePullSecrets = append(imagePullSecrets, corev1.LocalObjectReference{Name: secret})
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: name,
+					ImagePullSecrets:   imagePullSecrets,
+					Volumes:            nimService.GetTLSVolumes(),
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: ptr.To[bool](true),
+					},
+					Containers: []corev1.Container{
+						{
+							Name:         "activator",
+							Image:        image,
+							Command:      []string{"/manager"},
+							Args:         args,
+							VolumeMounts: nimService.GetTLSVolumeMounts(),
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "service-port",
+									ContainerPort: port,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("10m"),
+									corev1.ResourceMemory: resource.MustParse("32Mi"),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceMemory: resource.MustParse("128Mi"),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To[bool](false),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := r.syncOwnedResource(ctx, nimService, &corev1.ServiceAccount{}, serviceAccount); err != nil {
+		return err
+	}
+	if err := r.syncOwnedResource(ctx, nimService, &rbacv1.Role{}, role); err != nil {
+		return err
+	}
+	if err := r.syncOwnedResource(ctx, nimService, &rbacv1.RoleBinding{}, roleBinding); err != nil {
+		return err
+	}
+	if err := r.syncOwnedResource(ctx, nimService, &appsv1.Deployment{}, deployment); err != nil {
+		return err
+	}
+
+	// Allow the activator traffic when the NIMService pods are isolated
+	networkPolicyParams := nimService.GetActivatorNetworkPolicyParams()
+	if !networkPolicyParams.Enabled {
+		return r.cleanupResource(ctx, &networkingv1.NetworkPolicy{}, types.NamespacedName{Name: name, Namespace: namespace})
+	}
+	networkPolicy, err := r.renderer.NetworkPolicy(networkPolicyParams)
+	if err != nil {
+		return err
+	}
+	return r.syncOwnedResource(ctx, nimService, &networkingv1.NetworkPolicy{}, networkPolicy)
+}
+
+// cleanupActivator deletes the activator resources of the NIMService
+func (r *NIMServiceReconciler) cleanupActivator(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	namespacedName := types.NamespacedName{Name: nimService.GetActivatorName(), Namespace: nimService.GetNamespace()}
+	for _, obj := range []client.Object{&networkingv1.NetworkPolicy{}, &appsv1.Deployment{}, &rbacv1.RoleBinding{}, &rbacv1.Role{}, &corev1.ServiceAccount{}} {
+		if err := r.cleanupResource(ctx, obj, namespacedName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
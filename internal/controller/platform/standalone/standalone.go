@@ -33,10 +33,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const (
-	// ManifestsDir is the directory to render k8s resource manifests
-	ManifestsDir = "/manifests"
-)
+// ManifestsDir is the directory to render k8s resource manifests, replaced in tests
+var ManifestsDir = "/manifests"
 
 // Standalone implements the Platform interface for standalone deployment
 type Standalone struct{}
@@ -101,6 +99,11 @@ func (s *Standalone) Delete(ctx context.Context, r shared.Reconciler, resource c
 		}
 		return nil
 	}
+
+	if _, ok := resource.(*appsv1alpha1.NIMCache); ok {
+		// All dependent (owned) objects of NIMCache will be automatically garbage collected.
+		return nil
+	}
 	return errors.NewBadRequest("invalid resource type")
 }
 
@@ -124,5 +127,10 @@ func (s *Standalone) Sync(ctx context.Context, r shared.Reconciler, resource cli
 		}
 		return result, err
 	}
+
+	if _, ok := resource.(*appsv1alpha1.NIMCache); ok {
+		// NIM caching is fully handled by the NIMCache controller in standalone mode
+		return ctrl.Result{}, nil
+	}
 	return ctrl.Result{}, errors.NewBadRequest("invalid resource type")
 }
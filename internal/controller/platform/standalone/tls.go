@@ -0,0 +1,104 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// CertificateGVK is the GroupVersionKind of the cert-manager Certificate used for the serving certificate
+var CertificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+// reconcileTLS requests the serving certificate from cert-manager when configured and annotates the pods
+// with the hash of the certificate, so that they are rotated whenever the certificate is renewed
+func (r *NIMServiceReconciler) reconcileTLS(ctx context.Context, nimService *appsv1alpha1.NIMService, renderer render.Renderer, deploymentParams *rendertypes.DeploymentParams) error {
+	logger := log.FromContext(ctx)
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+
+	if nimService.IsCertManagerEnabled() {
+		err := r.renderAndSyncResource(ctx, nimService, &renderer, newUnstructured(CertificateGVK), func() (client.Object, error) {
+			return renderer.Certificate(nimService.GetCertificateParams())
+		}, "certificate", conditions.ReasonCertificateFailed)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Remove the certificate in case of a switch to an existing secret, ignoring clusters without cert-manager
+		err := r.cleanupResource(ctx, newUnstructured(CertificateGVK), namespacedName)
+		if err != nil && !meta.IsNoMatchError(err) {
+			return err
+		}
+	}
+
+	if !nimService.IsTLSEnabled() {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: nimService.GetTLSSecretName(), Namespace: nimService.GetNamespace()}, secret)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		// Pods wait for the secret volume, they are rotated once the certificate is issued
+		logger.Info("Waiting for the TLS secret", "secret", nimService.GetTLSSecretName())
+		return nil
+	}
+
+	annotations := map[string]string{
+		appsv1alpha1.TLSSecretHashAnnotationKey: utils.GetStringHash(string(secret.Data[corev1.TLSCertKey])),
+	}
+	deploymentParams.Annotations = utils.MergeMaps(deploymentParams.Annotations, annotations)
+	return nil
+}
+
+// getServingHTTPClient returns a client trusting the serving certificate of the NIMService. The certificate is
+// verified against the service name as it is shared with the candidate revision of a rollout
+func (r *NIMServiceReconciler) getServingHTTPClient(ctx context.Context, nimService *appsv1alpha1.NIMService) (*http.Client, error) {
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: nimService.GetTLSSecretName(), Namespace: nimService.GetNamespace()}, secret)
+	if err != nil {
+		return nil, err
+	}
+	rootCAs, err := utils.NewCertPool(string(utils.GetServingCA(secret.Data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS secret %s: %w", nimService.GetTLSSecretName(), err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    rootCAs,
+		ServerName: fmt.Sprintf("%s.%s.svc", nimService.GetName(), nimService.GetNamespace()),
+		MinVersion: tls.VersionTLS12,
+	}
+	return &http.Client{Transport: transport}, nil
+}
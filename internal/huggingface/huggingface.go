@@ -0,0 +1,228 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package huggingface
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+const (
+	// TokenEnv is the environment variable, and auth secret key, holding the Hugging Face token
+	TokenEnv = "HF_TOKEN"
+	// requestTimeout bounds the requests to the model API
+	requestTimeout = 30 * time.Second
+)
+
+// RepoInfo is the content of a model repository at a resolved commit
+type RepoInfo struct {
+	// SHA is the commit the requested revision resolved to
+	SHA string `json:"sha"`
+	// Siblings are the files of the repository
+	Siblings []RepoFile `json:"siblings"`
+}
+
+// RepoFile is a file of a model repository
+type RepoFile struct {
+	// RFilename is the path of the file relative to the repository root
+	RFilename string `json:"rfilename"`
+	// BlobID is the git blob id of the file, the git blob id of the pointer file for LFS files
+	BlobID string `json:"blobId,omitempty"`
+	// Size is the size of the file in bytes
+	Size int64 `json:"size,omitempty"`
+	// LFS is set for files stored with git LFS
+	LFS *LFSInfo `json:"lfs,omitempty"`
+}
+
+// LFSInfo is the git LFS object of a file
+type LFSInfo struct {
+	// SHA256 is the sha256 checksum of the file content
+	SHA256 string `json:"sha256"`
+	// Size is the size of the file content in bytes
+	Size int64 `json:"size"`
+}
+
+// GetSize returns the size of the file content in bytes
+func (f *RepoFile) GetSize() int64 {
+	if f.LFS != nil {
+		return f.LFS.Size
+	}
+	return f.Size
+}
+
+// Checksum returns the checksum of the file content the Hub serves as ETag, the sha256 checksum
+// of LFS files and the git blob id of the others, prefixed with its algorithm
+func (f *RepoFile) Checksum() string {
+	switch {
+	case f.LFS != nil && f.LFS.SHA256 != "":
+		return "sha256:" + f.LFS.SHA256
+	case f.BlobID != "":
+		return "gitsha1:" + f.BlobID
+	default:
+		return ""
+	}
+}
+
+// Client queries the model API of the Hugging Face Hub or an HF-compatible mirror
+type Client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the given Hub endpoint, authenticated with the token when set and trusting the
+// given CAs instead of the system ones when set
+func NewClient(endpoint, token string, rootCAs *x509.CertPool) *Client {
+	return &Client{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		token:      token,
+		httpClient: utils.NewHTTPClient(requestTimeout, rootCAs),
+	}
+}
+
+// GetRepoInfo resolves the revision of the model repository and returns its files with their checksums
+func (c *Client) GetRepoInfo(ctx context.Context, repoID, revision string) (*RepoInfo, error) {
+	reqURL := fmt.Sprintf("%s/api/models/%s/revision/%s?blobs=true", c.endpoint, repoID, url.PathEscape(revision))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model repository %s: %w", repoID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get revision %s of model repository %s: %s", revision, repoID, resp.Status)
+	}
+
+	info := &RepoInfo{}
+	if err := json.NewDecoder(resp.Body).Decode(info); err != nil {
+		return nil, fmt.Errorf("failed to decode model repository %s: %w", repoID, err)
+	}
+	if info.SHA == "" {
+		return nil, fmt.Errorf("revision %s of model repository %s did not resolve to a commit", revision, repoID)
+	}
+	return info, nil
+}
+
+// GetFilesSize returns the total size of the given files of the repository in bytes
+func (r *RepoInfo) GetFilesSize(files []string) int64 {
+	var size int64
+	for _, sibling := range r.Siblings {
+		for _, file := range files {
+			if sibling.RFilename == file {
+				size += sibling.GetSize()
+			}
+		}
+	}
+	return size
+}
+
+// FilterFiles returns the files of the repository matching any include pattern and no exclude pattern,
+// the patterns follow the fnmatch semantics of the huggingface-cli
+func (r *RepoInfo) FilterFiles(include, exclude []string) ([]string, error) {
+	includeRegexps, err := compilePatterns(include)
+	if err != nil {
+		return nil, err
+	}
+	excludeRegexps, err := compilePatterns(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, sibling := range r.Siblings {
+		if len(includeRegexps) > 0 && !matchAny(includeRegexps, sibling.RFilename) {
+			continue
+		}
+		if matchAny(excludeRegexps, sibling.RFilename) {
+			continue
+		}
+		files = append(files, sibling.RFilename)
+	}
+	return files, nil
+}
+
+func matchAny(regexps []*regexp.Regexp, name string) bool {
+	for _, re := range regexps {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	regexps := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		// A directory pattern matches all the files of the directory
+		if strings.HasSuffix(pattern, "/") {
+			pattern += "*"
+		}
+		re, err := regexp.Compile(translatePattern(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("invalid file pattern %q: %w", pattern, err)
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps, nil
+}
+
+// translatePattern converts a shell pattern to a regular expression, where * also matches the path separators
+func translatePattern(pattern string) string {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+	return sb.String()
+}
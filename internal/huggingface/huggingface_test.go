@@ -0,0 +1,144 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package huggingface
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRepoInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer hf_test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.EscapedPath() {
+		case "/api/models/meta-llama/Llama-3.1-8B/revision/main":
+			if r.URL.Query().Get("blobs") != "true" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"sha":"0123abcd","siblings":[{"rfilename":"config.json","blobId":"a1b2","size":12},` +
+				`{"rfilename":"model.safetensors","blobId":"c3d4","size":130,"lfs":{"sha256":"e5f6","size":4096}}]}`))
+		case "/api/models/meta-llama/Llama-3.1-8B/revision/refs%2Fpr%2F1":
+			_, _ = w.Write([]byte(`{"sha":"4567cdef","siblings":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL+"/", "hf_test", nil)
+	info, err := client.GetRepoInfo(context.TODO(), "meta-llama/Llama-3.1-8B", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123abcd", info.SHA)
+	assert.Equal(t, []RepoFile{
+		{RFilename: "config.json", BlobID: "a1b2", Size: 12},
+		{RFilename: "model.safetensors", BlobID: "c3d4", Size: 130, LFS: &LFSInfo{SHA256: "e5f6", Size: 4096}},
+	}, info.Siblings)
+	assert.Equal(t, "gitsha1:a1b2", info.Siblings[0].Checksum())
+	assert.Equal(t, "sha256:e5f6", info.Siblings[1].Checksum())
+	assert.Equal(t, int64(4108), info.GetFilesSize([]string{"config.json", "model.safetensors"}))
+
+	info, err = client.GetRepoInfo(context.TODO(), "meta-llama/Llama-3.1-8B", "refs/pr/1")
+	assert.NoError(t, err)
+	assert.Equal(t, "4567cdef", info.SHA)
+
+	_, err = client.GetRepoInfo(context.TODO(), "meta-llama/missing", "main")
+	assert.ErrorContains(t, err, "404")
+
+	_, err = NewClient(server.URL, "", nil).GetRepoInfo(context.TODO(), "meta-llama/Llama-3.1-8B", "main")
+	assert.ErrorContains(t, err, "401")
+}
+
+func TestGetRepoInfoCustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"sha":"0123abcd","siblings":[]}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "", nil).GetRepoInfo(context.TODO(), "meta-llama/Llama-3.1-8B", "main")
+	assert.ErrorContains(t, err, "certificate")
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	info, err := NewClient(server.URL, "", rootCAs).GetRepoInfo(context.TODO(), "meta-llama/Llama-3.1-8B", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123abcd", info.SHA)
+}
+
+func TestFilterFiles(t *testing.T) {
+	info := &RepoInfo{
+		SHA: "0123abcd",
+		Siblings: []RepoFile{
+			{RFilename: ".gitattributes"},
+			{RFilename: "config.json"},
+			{RFilename: "model-00001-of-00002.safetensors"},
+			{RFilename: "model-00002-of-00002.safetensors"},
+			{RFilename: "original/consolidated.00.pth"},
+			{RFilename: "original/params.json"},
+			{RFilename: "tokenizer.json"},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		include  []string
+		exclude  []string
+		expected []string
+	}{
+		{
+			name:     "all files",
+			expected: []string{".gitattributes", "config.json", "model-00001-of-00002.safetensors", "model-00002-of-00002.safetensors", "original/consolidated.00.pth", "original/params.json", "tokenizer.json"},
+		},
+		{
+			name:     "include with wildcards matching directories",
+			include:  []string{"*.json"},
+			expected: []string{"config.json", "original/params.json", "tokenizer.json"},
+		},
+		{
+			name:     "include and exclude",
+			include:  []string{"*.json", "model-0000[12]-of-?????.safetensors"},
+			exclude:  []string{"original/"},
+			expected: []string{"config.json", "model-00001-of-00002.safetensors", "model-00002-of-00002.safetensors", "tokenizer.json"},
+		},
+		{
+			name:     "negated character class",
+			include:  []string{"model-0000[!1]-*"},
+			expected: []string{"model-00002-of-00002.safetensors"},
+		},
+		{
+			name:     "no match",
+			include:  []string{"*.gguf"},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			files, err := info.FilterFiles(tc.include, tc.exclude)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, files)
+		})
+	}
+}
@@ -19,11 +19,15 @@ package k8sutil
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// GPUProductLabel is the node label with the GPU product name published by GPU feature discovery
+const GPUProductLabel = "nvidia.com/gpu.product"
+
 // OrchestratorType is the underlying container orchestrator type
 type OrchestratorType string
 
@@ -107,3 +111,38 @@ func GetOrchestratorType(k8sClient client.Client) (OrchestratorType, error) {
 	// Default to Upstream Kubernetes if no specific platform labels are found
 	return K8s, nil
 }
+
+// IsResourceAllocatable returns true if any node in the cluster advertises the given extended resource,
+// such as a GPU, MIG or time-sliced GPU resource, in its allocatable resources.
+func IsResourceAllocatable(ctx context.Context, k8sClient client.Client, resourceName corev1.ResourceName) (bool, error) {
+	nodes := &corev1.NodeList{}
+	err := k8sClient.List(ctx, nodes)
+	if err != nil {
+		return false, fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	for _, node := range nodes.Items {
+		if quantity, ok := node.Status.Allocatable[resourceName]; ok && !quantity.IsZero() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetNodeGPUProducts returns the value of the GPU product label of all nodes in the cluster, keyed by node name,
+// skipping nodes where this label is empty.
+func GetNodeGPUProducts(ctx context.Context, k8sClient client.Client) (map[string]string, error) {
+	nodes := &corev1.NodeList{}
+	err := k8sClient.List(ctx, nodes)
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	nodeGPUProducts := make(map[string]string)
+	for _, node := range nodes.Items {
+		if gpuProduct, ok := node.Labels[GPUProductLabel]; ok && strings.TrimSpace(gpuProduct) != "" {
+			nodeGPUProducts[node.Name] = gpuProduct
+		}
+	}
+	return nodeGPUProducts, nil
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package k8sutil
 
 import (
+	"context"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -65,3 +67,35 @@ func TestDetectPlatform(t *testing.T) {
 		})
 	}
 }
+
+func TestIsResourceAllocatable(t *testing.T) {
+	tests := []struct {
+		name         string
+		allocatable  corev1.ResourceList
+		resourceName corev1.ResourceName
+		expected     bool
+	}{
+		{"GPU allocatable", corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")}, "nvidia.com/gpu", true},
+		{"MIG device allocatable", corev1.ResourceList{"nvidia.com/mig-3g.40gb": resource.MustParse("2")}, "nvidia.com/mig-3g.40gb", true},
+		{"GPU not allocatable with MIG devices", corev1.ResourceList{"nvidia.com/mig-3g.40gb": resource.MustParse("2")}, "nvidia.com/gpu", false},
+		{"GPU advertised without devices", corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("0")}, "nvidia.com/gpu", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+				Status:     corev1.NodeStatus{Allocatable: tt.allocatable},
+			}
+			fakeClient := fake.NewClientBuilder().WithObjects(node).Build()
+
+			allocatable, err := IsResourceAllocatable(context.TODO(), fakeClient, tt.resourceName)
+			if err != nil {
+				t.Fatalf("IsResourceAllocatable failed: %v", err)
+			}
+			if allocatable != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, allocatable)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpuller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+)
+
+// TerminationMessagePath is the file the report of the caching job is written to, read back by the operator
+// from the terminated state of the caching container
+const TerminationMessagePath = "/dev/termination-log"
+
+// Report is the result of a model pull reported in the NIMCache status
+type Report struct {
+	// S3 is the result of the sync of an S3 prefix
+	S3 *appsv1alpha1.S3Status `json:"s3,omitempty"`
+	// Verification is the result of the verification of the cached profiles
+	Verification []appsv1alpha1.ProfileVerification `json:"verification,omitempty"`
+}
+
+// Pull downloads the model of the given source into the cache path
+func Pull(ctx context.Context, source *appsv1alpha1.NIMSource, path string) (*Report, error) {
+	switch {
+	case source.S3 != nil:
+		status, err := SyncS3(ctx, source.S3, path)
+		if err != nil {
+			return nil, err
+		}
+		return &Report{S3: status}, nil
+	case source.OCI != nil:
+		credentials, err := mountedCredentials(source.OCI.Reference)
+		if err != nil {
+			return nil, err
+		}
+		if err := PullOCI(ctx, source.OCI, credentials, path); err != nil {
+			return nil, err
+		}
+		return &Report{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported model source for the model puller")
+	}
+}
+
+// WriteReport writes the report to the given file
+func WriteReport(report *Report, file string) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, data, 0644)
+}
+
+// ParseReport parses the report written by the caching job
+func ParseReport(message string) (*Report, error) {
+	report := &Report{}
+	if err := json.Unmarshal([]byte(message), report); err != nil {
+		return nil, fmt.Errorf("invalid model puller report: %w", err)
+	}
+	return report, nil
+}
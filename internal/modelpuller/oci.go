@@ -0,0 +1,582 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpuller
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// RegistryAuthPath is the directory the docker config secrets are mounted in, one sub-directory per secret
+	RegistryAuthPath = "/etc/nim/registry-auth"
+	// DockerConfigFile is the file name of the docker config in the mounted secrets
+	DockerConfigFile = "config.json"
+
+	// titleAnnotation is the file name of an artifact layer
+	titleAnnotation = "org.opencontainers.image.title"
+	// unpackAnnotation marks the artifact layers archiving a directory
+	unpackAnnotation = "io.deis.oras.content.unpack"
+	// manifestMediaTypes are the accepted manifest media types
+	manifestMediaTypes = "application/vnd.oci.image.manifest.v1+json, application/vnd.docker.distribution.manifest.v2+json"
+	// defaultRegistry is the registry of the references without registry
+	defaultRegistry = "docker.io"
+	// resolveTimeout bounds the registry requests resolving the artifact
+	resolveTimeout = 30 * time.Second
+)
+
+var challengeParamRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// RegistryCredentials are the credentials to pull from a registry
+type RegistryCredentials struct {
+	Username string
+	Password string
+}
+
+// ociReference is a parsed artifact reference
+type ociReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// ociDescriptor is a content descriptor of a manifest
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ociManifest is an image or artifact manifest
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+// registryClient pulls the content of a repository with the distribution API
+type registryClient struct {
+	baseURL       string
+	ref           *ociReference
+	credentials   *RegistryCredentials
+	authorization string
+	httpClient    *http.Client
+}
+
+// parseReference parses an artifact reference, <registry>/<repository>[:<tag>][@<digest>]
+func parseReference(reference string) (*ociReference, error) {
+	ref := &ociReference{}
+	name := reference
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.Digest = name[:i], name[i+1:]
+		if !strings.HasPrefix(ref.Digest, "sha256:") {
+			return nil, fmt.Errorf("unsupported digest in reference %s", reference)
+		}
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.Tag = name[:i], name[i+1:]
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = "latest"
+	}
+
+	registry, repository, found := strings.Cut(name, "/")
+	if !found || (!strings.ContainsAny(registry, ".:") && registry != "localhost") {
+		registry, repository = defaultRegistry, name
+		if !strings.Contains(repository, "/") {
+			repository = "library/" + repository
+		}
+	}
+	if repository == "" {
+		return nil, fmt.Errorf("invalid reference %s", reference)
+	}
+	ref.Registry, ref.Repository = registry, repository
+	return ref, nil
+}
+
+// String returns the reference, pinned to the digest when set
+func (r *ociReference) String() string {
+	if r.Digest != "" {
+		return fmt.Sprintf("%s/%s@%s", r.Registry, r.Repository, r.Digest)
+	}
+	return fmt.Sprintf("%s/%s:%s", r.Registry, r.Repository, r.Tag)
+}
+
+// GetOCIRegistry returns the registry of the artifact reference
+func GetOCIRegistry(reference string) (string, error) {
+	ref, err := parseReference(reference)
+	if err != nil {
+		return "", err
+	}
+	return ref.Registry, nil
+}
+
+// PinOCIReference returns the reference of the artifact pinned to the digest
+func PinOCIReference(reference, digest string) (string, error) {
+	ref, err := parseReference(reference)
+	if err != nil {
+		return "", err
+	}
+	ref.Digest = digest
+	return ref.String(), nil
+}
+
+// DockerConfigCredentials returns the credentials for the registry in the docker config, nil when there are none
+func DockerConfigCredentials(data []byte, registry string) (*RegistryCredentials, error) {
+	config := struct {
+		Auths map[string]struct {
+			Auth     string `json:"auth"`
+			Username string `json:"username"`
+			Password string `json:"password"`
+		} `json:"auths"`
+	}{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid docker config: %w", err)
+	}
+	for server, auth := range config.Auths {
+		host := strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+		host, _, _ = strings.Cut(host, "/")
+		if host != registry && !(registry == defaultRegistry && host == "index.docker.io") {
+			continue
+		}
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("invalid auth for registry %s: %w", registry, err)
+			}
+			username, password, _ := strings.Cut(string(decoded), ":")
+			return &RegistryCredentials{Username: username, Password: password}, nil
+		}
+		return &RegistryCredentials{Username: auth.Username, Password: auth.Password}, nil
+	}
+	return nil, nil
+}
+
+// mountedCredentials returns the credentials for the registry of the reference in the mounted docker config secrets
+func mountedCredentials(reference string) (*RegistryCredentials, error) {
+	registry, err := GetOCIRegistry(reference)
+	if err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(RegistryAuthPath, "*", DockerConfigFile))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		credentials, err := DockerConfigCredentials(data, registry)
+		if err != nil || credentials != nil {
+			return credentials, err
+		}
+	}
+	return nil, nil
+}
+
+// newRegistryClient returns a client for the repository of the artifact
+func newRegistryClient(source *appsv1alpha1.OCISource, credentials *RegistryCredentials, httpClient *http.Client) (*registryClient, error) {
+	ref, err := parseReference(source.Reference)
+	if err != nil {
+		return nil, err
+	}
+	scheme := "https"
+	if source.PlainHTTP {
+		scheme = "http"
+	}
+	host := ref.Registry
+	if host == defaultRegistry {
+		host = "registry-1.docker.io"
+	}
+	return &registryClient{
+		baseURL:     fmt.Sprintf("%s://%s/v2/%s", scheme, host, ref.Repository),
+		ref:         ref,
+		credentials: credentials,
+		httpClient:  httpClient,
+	}, nil
+}
+
+// ResolveOCI resolves the reference of the artifact to the digest of its manifest and lists its files, rootCAs
+// are the trusted certificate authorities of the registry, the system ones when nil
+func ResolveOCI(ctx context.Context, source *appsv1alpha1.OCISource, credentials *RegistryCredentials, rootCAs *x509.CertPool) (*appsv1alpha1.OCIStatus, error) {
+	c, err := newRegistryClient(source, credentials, utils.NewHTTPClient(resolveTimeout, rootCAs))
+	if err != nil {
+		return nil, err
+	}
+	manifest, digest, err := c.getManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	var size int64
+	for _, layer := range manifest.Layers {
+		if title := layer.Annotations[titleAnnotation]; title != "" {
+			files = append(files, title)
+		}
+		size += layer.Size
+	}
+	return &appsv1alpha1.OCIStatus{
+		Reference: source.Reference,
+		Digest:    digest,
+		Files:     files,
+		Size:      size,
+	}, nil
+}
+
+// GetOCIChecksums returns the checksums of the files of the artifact, the digests of the layers written to a titled file
+func GetOCIChecksums(ctx context.Context, source *appsv1alpha1.OCISource, credentials *RegistryCredentials, rootCAs *x509.CertPool) (map[string]string, error) {
+	c, err := newRegistryClient(source, credentials, utils.NewHTTPClient(resolveTimeout, rootCAs))
+	if err != nil {
+		return nil, err
+	}
+	manifest, _, err := c.getManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checksums := map[string]string{}
+	for _, layer := range manifest.Layers {
+		if title := layer.Annotations[titleAnnotation]; title != "" && layer.Annotations[unpackAnnotation] != "true" {
+			checksums[title] = layer.Digest
+		}
+	}
+	return checksums, nil
+}
+
+// PullOCI downloads the layers of the artifact into the cache path, the layers with a title are written to the
+// titled file and the archived directories and image layers are extracted
+func PullOCI(ctx context.Context, source *appsv1alpha1.OCISource, credentials *RegistryCredentials, path string) error {
+	logger := log.FromContext(ctx)
+	// The layers are downloaded for as long as they keep streaming, the job trusts the custom certificates through
+	// the mounted certificate directory
+	c, err := newRegistryClient(source, credentials, utils.NewHTTPClient(0, nil))
+	if err != nil {
+		return err
+	}
+	manifest, digest, err := c.getManifest(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("pulling artifact", "reference", source.Reference, "digest", digest, "layers", len(manifest.Layers))
+
+	for _, layer := range manifest.Layers {
+		if err := c.pullLayer(ctx, layer, path); err != nil {
+			return fmt.Errorf("failed to pull layer %s: %w", layer.Digest, err)
+		}
+		logger.Info("layer pulled", "digest", layer.Digest, "title", layer.Annotations[titleAnnotation], "bytes", layer.Size)
+	}
+	return nil
+}
+
+// getManifest returns the manifest of the reference and its digest
+func (c *registryClient) getManifest(ctx context.Context) (*ociManifest, string, error) {
+	ref := c.ref.Tag
+	if c.ref.Digest != "" {
+		ref = c.ref.Digest
+	}
+	resp, err := c.get(ctx, "/manifests/"+ref, manifestMediaTypes)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get manifest of %s: %w", c.ref, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sum := sha256.Sum256(data)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+	if c.ref.Digest != "" && digest != c.ref.Digest {
+		return nil, "", fmt.Errorf("manifest of %s does not match its digest", c.ref)
+	}
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, "", fmt.Errorf("invalid manifest of %s: %w", c.ref, err)
+	}
+	if strings.Contains(manifest.MediaType, "index") || strings.Contains(manifest.MediaType, "list") {
+		return nil, "", fmt.Errorf("%s is an index, a manifest is expected", c.ref)
+	}
+	return manifest, digest, nil
+}
+
+// pullLayer downloads the layer blob, verifies its digest and writes or extracts it into the cache path
+func (c *registryClient) pullLayer(ctx context.Context, layer ociDescriptor, path string) error {
+	resp, err := c.get(ctx, "/blobs/"+layer.Digest, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+	blob, err := os.CreateTemp(path, ".blob-*"+partialSuffix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(blob.Name())
+	defer blob.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(blob, h), resp.Body)
+	if err != nil {
+		return err
+	}
+	if digest := "sha256:" + hex.EncodeToString(h.Sum(nil)); digest != layer.Digest || n != layer.Size {
+		return fmt.Errorf("layer content does not match its digest and size")
+	}
+	if _, err := blob.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	title := layer.Annotations[titleAnnotation]
+	switch {
+	case title != "" && layer.Annotations[unpackAnnotation] != "true":
+		if !filepath.IsLocal(title) {
+			return fmt.Errorf("layer title %s is outside of the cache path", title)
+		}
+		dest := filepath.Join(path, title)
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return err
+		}
+		if err := blob.Close(); err != nil {
+			return err
+		}
+		return os.Rename(blob.Name(), dest)
+	case title != "":
+		// ORAS archives directories relative to their parent
+		return extractTar(blob, strings.Contains(layer.MediaType, "gzip"), path)
+	case strings.Contains(layer.MediaType, "tar"):
+		return extractTar(blob, strings.Contains(layer.MediaType, "gzip"), path)
+	default:
+		return fmt.Errorf("layer of media type %s without title cannot be extracted", layer.MediaType)
+	}
+}
+
+// extractTar extracts the directories, regular files and relative symbolic links of the archive into the path
+func extractTar(r io.Reader, gzipped bool, path string) error {
+	if gzipped {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		name := filepath.Clean(header.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("archive entry %s is outside of the cache path", header.Name)
+		}
+		dest := filepath.Join(path, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := checkNoSymlink(path, name); err != nil {
+				return err
+			}
+			if err := os.MkdirAll(dest, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := checkNoSymlink(path, name); err != nil {
+				return err
+			}
+			if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(dest, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, header.FileInfo().Mode().Perm()|0600)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if filepath.IsAbs(header.Linkname) || !filepath.IsLocal(filepath.Join(filepath.Dir(name), header.Linkname)) {
+				return fmt.Errorf("archive link %s is outside of the cache path", header.Name)
+			}
+			if err := checkNoSymlink(path, filepath.Dir(name)); err != nil {
+				return err
+			}
+			if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+				return err
+			}
+			_ = os.Remove(dest)
+			if err := os.Symlink(header.Linkname, dest); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// checkNoSymlink returns an error when the entry or one of its parent directories in the path is a symbolic link,
+// links are only followed when reading the cache
+func checkNoSymlink(path, name string) error {
+	dest := path
+	for _, elem := range strings.Split(name, string(filepath.Separator)) {
+		dest = filepath.Join(dest, elem)
+		info, err := os.Lstat(dest)
+		if os.IsNotExist(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("archive entry %s is written through the link %s", name, strings.TrimPrefix(dest, path+string(filepath.Separator)))
+		}
+	}
+	return nil
+}
+
+// get sends a GET request to the repository, authenticating on the challenge of the registry
+func (c *registryClient) get(ctx context.Context, path, accept string) (*http.Response, error) {
+	resp, err := c.send(ctx, c.baseURL+path, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && c.authorization == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := c.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+		resp, err = c.send(ctx, c.baseURL+path, accept)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return resp, nil
+}
+
+func (c *registryClient) send(ctx context.Context, url, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if c.authorization != "" {
+		req.Header.Set("Authorization", c.authorization)
+	}
+	return c.httpClient.Do(req)
+}
+
+// authenticate sets the authorization for the basic or bearer token challenge of the registry
+func (c *registryClient) authenticate(ctx context.Context, challenge string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.credentials == nil {
+			return fmt.Errorf("registry %s requires credentials", c.ref.Registry)
+		}
+		auth := base64.StdEncoding.EncodeToString([]byte(c.credentials.Username + ":" + c.credentials.Password))
+		c.authorization = "Basic " + auth
+		return nil
+	case "bearer":
+	default:
+		return fmt.Errorf("unsupported authentication challenge %q of registry %s", challenge, c.ref.Registry)
+	}
+
+	values := map[string]string{}
+	for _, match := range challengeParamRegexp.FindAllStringSubmatch(params, -1) {
+		values[match[1]] = match[2]
+	}
+	realm, err := url.Parse(values["realm"])
+	if err != nil || realm.Host == "" {
+		return fmt.Errorf("invalid authentication realm %q of registry %s", values["realm"], c.ref.Registry)
+	}
+	query := realm.Query()
+	if service := values["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := values["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", c.ref.Repository)
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	if c.credentials != nil {
+		req.SetBasicAuth(c.credentials.Username, c.credentials.Password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get token of registry %s: %w", c.ref.Registry, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get token of registry %s: %s", c.ref.Registry, resp.Status)
+	}
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return fmt.Errorf("invalid token of registry %s: %w", c.ref.Registry, err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	c.authorization = "Bearer " + token.Token
+	return nil
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpuller
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReference(t *testing.T) {
+	testcases := []struct {
+		reference string
+		expected  *ociReference
+	}{
+		{
+			reference: "registry.example.com/models/llama3:1.0",
+			expected:  &ociReference{Registry: "registry.example.com", Repository: "models/llama3", Tag: "1.0"},
+		},
+		{
+			reference: "localhost:5000/llama3@sha256:0123",
+			expected:  &ociReference{Registry: "localhost:5000", Repository: "llama3", Digest: "sha256:0123"},
+		},
+		{
+			reference: "models/llama3",
+			expected:  &ociReference{Registry: "docker.io", Repository: "models/llama3", Tag: "latest"},
+		},
+		{
+			reference: "llama3:1.0@sha256:0123",
+			expected:  &ociReference{Registry: "docker.io", Repository: "library/llama3", Tag: "1.0", Digest: "sha256:0123"},
+		},
+	}
+	for _, tc := range testcases {
+		ref, err := parseReference(tc.reference)
+		assert.NoError(t, err, tc.reference)
+		assert.Equal(t, tc.expected, ref, tc.reference)
+	}
+
+	_, err := parseReference("registry.example.com/llama3@md5:0123")
+	assert.Error(t, err)
+
+	pinned, err := PinOCIReference("registry.example.com/models/llama3:1.0", "sha256:0123")
+	assert.NoError(t, err)
+	assert.Equal(t, "registry.example.com/models/llama3@sha256:0123", pinned)
+}
+
+func TestDockerConfigCredentials(t *testing.T) {
+	config := []byte(`{"auths":{"https://registry.example.com/v1/":{"auth":"dXNlcjpwYXNz"},"index.docker.io":{"username":"hub","password":"secret"}}}`)
+
+	credentials, err := DockerConfigCredentials(config, "registry.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, &RegistryCredentials{Username: "user", Password: "pass"}, credentials)
+
+	credentials, err = DockerConfigCredentials(config, "docker.io")
+	assert.NoError(t, err)
+	assert.Equal(t, &RegistryCredentials{Username: "hub", Password: "secret"}, credentials)
+
+	credentials, err = DockerConfigCredentials(config, "nvcr.io")
+	assert.NoError(t, err)
+	assert.Nil(t, credentials)
+}
+
+func blobDigest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// newRegistryServer returns a registry stand-in serving the models/llama3:1.0 artifact behind a bearer token challenge,
+// with a file layer and an archived directory layer
+func newRegistryServer(t *testing.T) (*httptest.Server, string) {
+	var archive bytes.Buffer
+	gz := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gz)
+	for _, entry := range []struct{ name, data string }{{"tokenizer/", ""}, {"tokenizer/tokenizer.json", `{"version":"1.0"}`}} {
+		header := &tar.Header{Name: entry.name, Mode: 0644, Size: int64(len(entry.data)), Typeflag: tar.TypeReg}
+		if strings.HasSuffix(entry.name, "/") {
+			header.Typeflag, header.Mode = tar.TypeDir, 0755
+		}
+		_ = tw.WriteHeader(header)
+		_, _ = tw.Write([]byte(entry.data))
+	}
+	_ = tw.Close()
+	_ = gz.Close()
+
+	blobs := map[string][]byte{}
+	weights := []byte("weights")
+	blobs[blobDigest(weights)] = weights
+	blobs[blobDigest(archive.Bytes())] = archive.Bytes()
+	manifest, _ := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"artifactType":  "application/vnd.nvidia.nim.model.v1",
+		"layers": []ociDescriptor{
+			{MediaType: "application/octet-stream", Digest: blobDigest(weights), Size: int64(len(weights)),
+				Annotations: map[string]string{titleAnnotation: "model.safetensors"}},
+			{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: blobDigest(archive.Bytes()), Size: int64(archive.Len()),
+				Annotations: map[string]string{titleAnnotation: "tokenizer", unpackAnnotation: "true"}},
+		},
+	})
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" || r.URL.Query().Get("scope") != "repository:models/llama3:pull" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token":"registry-token"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer registry-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:models/llama3:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.URL.Path == "/v2/models/llama3/manifests/1.0" || r.URL.Path == "/v2/models/llama3/manifests/"+blobDigest(manifest):
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			_, _ = w.Write(manifest)
+		case strings.HasPrefix(r.URL.Path, "/v2/models/llama3/blobs/"):
+			blob, ok := blobs[strings.TrimPrefix(r.URL.Path, "/v2/models/llama3/blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(blob)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, blobDigest(manifest)
+}
+
+func TestResolveAndPullOCI(t *testing.T) {
+	server, manifestDigest := newRegistryServer(t)
+	registry := strings.TrimPrefix(server.URL, "http://")
+	credentials := &RegistryCredentials{Username: "user", Password: "pass"}
+	source := &appsv1alpha1.OCISource{Reference: registry + "/models/llama3:1.0", PlainHTTP: true}
+
+	status, err := ResolveOCI(context.TODO(), source, credentials, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &appsv1alpha1.OCIStatus{
+		Reference: source.Reference,
+		Digest:    manifestDigest,
+		Files:     []string{"model.safetensors", "tokenizer"},
+		Size:      status.Size,
+	}, status)
+	// the layer sizes include the compressed tokenizer archive
+	assert.Greater(t, status.Size, int64(len("weights")))
+
+	_, err = ResolveOCI(context.TODO(), source, nil, nil)
+	assert.ErrorContains(t, err, "failed to get token")
+
+	// Pull the artifact pinned to the resolved digest
+	pinned, err := PinOCIReference(source.Reference, status.Digest)
+	assert.NoError(t, err)
+	path := t.TempDir()
+	assert.NoError(t, PullOCI(context.TODO(), &appsv1alpha1.OCISource{Reference: pinned, PlainHTTP: true}, credentials, path))
+
+	data, err := os.ReadFile(filepath.Join(path, "model.safetensors"))
+	assert.NoError(t, err)
+	assert.Equal(t, "weights", string(data))
+	data, err = os.ReadFile(filepath.Join(path, "tokenizer", "tokenizer.json"))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"version":"1.0"}`, string(data))
+	entries, err := os.ReadDir(path)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	// The titled files verify against the layer digests
+	checksums, err := GetOCIChecksums(context.TODO(), &appsv1alpha1.OCISource{Reference: pinned, PlainHTTP: true}, credentials, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"model.safetensors": blobDigest([]byte("weights"))}, checksums)
+	checksumsPath := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(checksumsPath, "oci"), []byte(FormatChecksums(checksums)), 0644))
+	results, err := Verify(context.TODO(), checksumsPath, path)
+	assert.NoError(t, err)
+	assert.Equal(t, []appsv1alpha1.ProfileVerification{{Profile: "oci", Files: 1, VerifiedFiles: 1}}, results)
+
+	// A digest not matching the manifest is rejected
+	err = PullOCI(context.TODO(), &appsv1alpha1.OCISource{Reference: registry + "/models/llama3@" + blobDigest([]byte("other")), PlainHTTP: true}, credentials, path)
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestResolveOCITLS(t *testing.T) {
+	manifest := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[]}`)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(manifest)
+	}))
+	defer server.Close()
+	source := &appsv1alpha1.OCISource{Reference: strings.TrimPrefix(server.URL, "https://") + "/models/llama3:1.0"}
+
+	_, err := ResolveOCI(context.TODO(), source, nil, nil)
+	assert.ErrorContains(t, err, "certificate")
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	status, err := ResolveOCI(context.TODO(), source, nil, rootCAs)
+	assert.NoError(t, err)
+	assert.Equal(t, blobDigest(manifest), status.Digest)
+}
+
+func TestExtractTar(t *testing.T) {
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	_ = tw.WriteHeader(&tar.Header{Name: "../escape.txt", Mode: 0644, Size: 1, Typeflag: tar.TypeReg})
+	_, _ = tw.Write([]byte("x"))
+	_ = tw.Close()
+
+	err := extractTar(&archive, false, t.TempDir())
+	assert.ErrorContains(t, err, "outside of the cache path")
+
+	for _, tc := range []struct {
+		name    string
+		headers []tar.Header
+		err     string
+	}{
+		{
+			name:    "absolute link",
+			headers: []tar.Header{{Name: "x", Linkname: "/etc", Typeflag: tar.TypeSymlink}},
+			err:     "outside of the cache path",
+		},
+		{
+			name:    "root link",
+			headers: []tar.Header{{Name: "x", Linkname: "/", Typeflag: tar.TypeSymlink}},
+			err:     "outside of the cache path",
+		},
+		{
+			name:    "parent link",
+			headers: []tar.Header{{Name: "sub/x", Linkname: "../..", Typeflag: tar.TypeSymlink}},
+			err:     "outside of the cache path",
+		},
+		{
+			name: "file through link",
+			headers: []tar.Header{
+				{Name: "sub", Typeflag: tar.TypeDir, Mode: 0755},
+				{Name: "x", Linkname: "sub", Typeflag: tar.TypeSymlink},
+				{Name: "x/escape.txt", Typeflag: tar.TypeReg, Mode: 0644, Size: 1},
+			},
+			err: "written through the link x",
+		},
+		{
+			name: "file over link",
+			headers: []tar.Header{
+				{Name: "x", Linkname: "y", Typeflag: tar.TypeSymlink},
+				{Name: "x", Typeflag: tar.TypeReg, Mode: 0644, Size: 1},
+			},
+			err: "written through the link x",
+		},
+		{
+			name: "link through link",
+			headers: []tar.Header{
+				{Name: "sub", Typeflag: tar.TypeDir, Mode: 0755},
+				{Name: "x", Linkname: "sub", Typeflag: tar.TypeSymlink},
+				{Name: "x/y", Linkname: "..", Typeflag: tar.TypeSymlink},
+			},
+			err: "written through the link x",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var archive bytes.Buffer
+			tw := tar.NewWriter(&archive)
+			for _, header := range tc.headers {
+				assert.NoError(t, tw.WriteHeader(&header))
+				if header.Size > 0 {
+					_, _ = tw.Write([]byte("x"))
+				}
+			}
+			assert.NoError(t, tw.Close())
+
+			dir := t.TempDir()
+			path := filepath.Join(dir, "cache")
+			assert.NoError(t, os.Mkdir(path, 0755))
+			err := extractTar(&archive, false, path)
+			assert.ErrorContains(t, err, tc.err)
+			_, err = os.Stat(filepath.Join(dir, "escape.txt"))
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+
+	// Relative links within the cache path are kept
+	archive.Reset()
+	tw = tar.NewWriter(&archive)
+	_ = tw.WriteHeader(&tar.Header{Name: "weights/model.safetensors", Mode: 0644, Size: 1, Typeflag: tar.TypeReg})
+	_, _ = tw.Write([]byte("x"))
+	_ = tw.WriteHeader(&tar.Header{Name: "model.safetensors", Linkname: "weights/model.safetensors", Typeflag: tar.TypeSymlink})
+	_ = tw.Close()
+	path := t.TempDir()
+	assert.NoError(t, extractTar(&archive, false, path))
+	data, err := os.ReadFile(filepath.Join(path, "model.safetensors"))
+	assert.NoError(t, err)
+	assert.Equal(t, "x", string(data))
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpuller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProgressInterval is the interval the caching progress is measured and reported at
+const ProgressInterval = 30 * time.Second
+
+// ProgressTarget is a profile whose caching progress is measured
+type ProgressTarget struct {
+	// Name is the profile
+	Name string `json:"name"`
+	// Paths are the files and directories of the profile relative to the cache, the size of the profile is not
+	// measured when empty
+	Paths []string `json:"paths,omitempty"`
+	// TotalBytes is the size of the profile when known from the source
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+}
+
+// Progress is the caching progress written by the progress sidecar as a JSON log line
+type Progress struct {
+	// Profiles is the progress of each profile
+	Profiles []appsv1alpha1.ProfileProgress `json:"profiles"`
+	// TotalBytes is the size of the cache
+	TotalBytes int64 `json:"totalBytes"`
+}
+
+// ReportProgress measures the caching progress of the targets every interval and writes it as a JSON line,
+// a last measurement is written once the context is done
+func ReportProgress(ctx context.Context, targets []ProgressTarget, path string, interval time.Duration, w io.Writer) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var progress *Progress
+	for {
+		progress = MeasureProgress(targets, path, progress, time.Now())
+		data, err := json.Marshal(progress)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w, string(data)); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			progress = MeasureProgress(targets, path, progress, time.Now())
+			data, err := json.Marshal(progress)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(w, string(data))
+			return err
+		case <-ticker.C:
+		}
+	}
+}
+
+// MeasureProgress measures the size of the cache and of the targets, the start and finish times of the
+// previous measurement are kept
+func MeasureProgress(targets []ProgressTarget, path string, previous *Progress, now time.Time) *Progress {
+	progress := &Progress{TotalBytes: diskUsage(path, false)}
+	for i, target := range targets {
+		profile := appsv1alpha1.ProfileProgress{Name: target.Name, TotalBytes: target.TotalBytes}
+		if previous != nil && i < len(previous.Profiles) {
+			profile.StartTime = previous.Profiles[i].StartTime
+			profile.FinishTime = previous.Profiles[i].FinishTime
+		}
+
+		for _, p := range target.Paths {
+			profile.BytesDownloaded += diskUsage(filepath.Join(path, p), true)
+		}
+		switch {
+		case len(target.Paths) == 0:
+			// NGC profiles without files of NGC repositories in the model manifest are only reported in their state
+			profile.State = appsv1alpha1.ProfileProgressDownloading
+		case profile.TotalBytes > 0 && profile.BytesDownloaded >= profile.TotalBytes:
+			profile.State = appsv1alpha1.ProfileProgressComplete
+		case profile.BytesDownloaded > 0:
+			profile.State = appsv1alpha1.ProfileProgressDownloading
+		default:
+			profile.State = appsv1alpha1.ProfileProgressPending
+		}
+
+		if profile.State != appsv1alpha1.ProfileProgressPending && profile.StartTime == nil {
+			profile.StartTime = &metav1.Time{Time: now}
+		}
+		if profile.State == appsv1alpha1.ProfileProgressComplete && profile.FinishTime == nil {
+			profile.FinishTime = &metav1.Time{Time: now}
+		}
+		progress.Profiles = append(progress.Profiles, profile)
+	}
+	return progress
+}
+
+// ParseProgress returns the last caching progress written in the logs of the progress sidecar
+func ParseProgress(logs string) (*Progress, error) {
+	var progress *Progress
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, `{"profiles"`) {
+			continue
+		}
+		p := &Progress{}
+		if err := json.Unmarshal([]byte(line), p); err == nil {
+			progress = p
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if progress == nil {
+		return nil, fmt.Errorf("no caching progress reported")
+	}
+	return progress, nil
+}
+
+// diskUsage returns the size of the file or of the files of the directory, files being written or removed while
+// measured are skipped
+func diskUsage(path string, followSymlinks bool) int64 {
+	var size int64
+	_ = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		var info fs.FileInfo
+		switch {
+		case d.Type()&fs.ModeSymlink != 0 && followSymlinks:
+			// the NIM and Hugging Face caches link the files of a snapshot to shared blobs
+			info, err = os.Stat(p)
+		case d.Type().IsRegular():
+			info, err = d.Info()
+		default:
+			return nil
+		}
+		if err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpuller
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMeasureProgress(t *testing.T) {
+	path := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(path, "blobs"), 0755))
+	assert.NoError(t, os.MkdirAll(filepath.Join(path, "snapshot"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "config.json"), []byte("0123456789"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "blobs", "abcd"), []byte("weights"), 0644))
+	assert.NoError(t, os.Symlink("../blobs/abcd", filepath.Join(path, "snapshot", "model.safetensors")))
+
+	targets := []ProgressTarget{
+		{Name: "huggingface", Paths: []string{"config.json", "model.safetensors"}, TotalBytes: 20},
+		{Name: "snapshot", Paths: []string{"snapshot"}, TotalBytes: 7},
+		{Name: "missing", Paths: []string{"missing"}},
+		{Name: "ngc"},
+	}
+	start := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	progress := MeasureProgress(targets, path, nil, start)
+	// the linked blob is counted once in the cache size
+	assert.Equal(t, int64(17), progress.TotalBytes)
+	assert.Equal(t, []appsv1alpha1.ProfileProgress{
+		{Name: "huggingface", State: appsv1alpha1.ProfileProgressDownloading, BytesDownloaded: 10, TotalBytes: 20, StartTime: &metav1.Time{Time: start}},
+		{Name: "snapshot", State: appsv1alpha1.ProfileProgressComplete, BytesDownloaded: 7, TotalBytes: 7, StartTime: &metav1.Time{Time: start}, FinishTime: &metav1.Time{Time: start}},
+		{Name: "missing", State: appsv1alpha1.ProfileProgressPending},
+		{Name: "ngc", State: appsv1alpha1.ProfileProgressDownloading, StartTime: &metav1.Time{Time: start}},
+	}, progress.Profiles)
+
+	// the start time is kept as the download completes
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "model.safetensors"), []byte("0123456789"), 0644))
+	finish := start.Add(time.Hour)
+	progress = MeasureProgress(targets, path, progress, finish)
+	assert.Equal(t, appsv1alpha1.ProfileProgress{
+		Name: "huggingface", State: appsv1alpha1.ProfileProgressComplete, BytesDownloaded: 20, TotalBytes: 20,
+		StartTime: &metav1.Time{Time: start}, FinishTime: &metav1.Time{Time: finish},
+	}, progress.Profiles[0])
+	assert.Equal(t, &metav1.Time{Time: start}, progress.Profiles[1].FinishTime)
+}
+
+func TestReportProgress(t *testing.T) {
+	path := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "model.safetensors"), []byte("weights"), 0644))
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	var logs bytes.Buffer
+	logs.WriteString("INFO\tstarting progress reporter\n")
+	assert.NoError(t, ReportProgress(ctx, []ProgressTarget{{Name: "s3", Paths: []string{"."}}}, path, time.Hour, &logs))
+
+	progress, err := ParseProgress(logs.String() + `{"profiles":` + "\n")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), progress.TotalBytes)
+	assert.Len(t, progress.Profiles, 1)
+	assert.Equal(t, appsv1alpha1.ProfileProgressDownloading, progress.Profiles[0].State)
+	assert.Equal(t, int64(7), progress.Profiles[0].BytesDownloaded)
+
+	_, err = ParseProgress("INFO\tstarting progress reporter\n")
+	assert.ErrorContains(t, err, "no caching progress reported")
+}
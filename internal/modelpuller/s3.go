@@ -0,0 +1,457 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpuller
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// emptyPayloadHash is the SHA256 of the empty body of the signed requests
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	// partialSuffix is the suffix of the files being downloaded
+	partialSuffix = ".partial"
+	// s3RequestTimeout bounds the requests listing the checksums of the objects
+	s3RequestTimeout = 30 * time.Second
+)
+
+// listBucketResult is the response of the ListObjectsV2 API
+type listBucketResult struct {
+	IsTruncated           bool       `xml:"IsTruncated"`
+	NextContinuationToken string     `xml:"NextContinuationToken"`
+	Contents              []s3Object `xml:"Contents"`
+}
+
+// s3Object is an object of a ListObjectsV2 response
+type s3Object struct {
+	Key  string `xml:"Key"`
+	ETag string `xml:"ETag"`
+	Size int64  `xml:"Size"`
+}
+
+// S3Credentials are the credentials to read the objects of a bucket
+type S3Credentials struct {
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+}
+
+// s3Client reads objects from an S3-compatible service with path-style requests signed with AWS Signature Version 4
+type s3Client struct {
+	endpoint     *url.URL
+	region       string
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	httpClient   *http.Client
+}
+
+// newS3Client returns a client for the source, the objects are read anonymously without credentials
+func newS3Client(source *appsv1alpha1.S3Source, credentials *S3Credentials, httpClient *http.Client) (*s3Client, error) {
+	endpoint, err := url.Parse(source.GetEndpoint())
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", source.GetEndpoint())
+	}
+	c := &s3Client{
+		endpoint:   endpoint,
+		region:     source.GetRegion(),
+		httpClient: httpClient,
+	}
+	if credentials != nil {
+		c.accessKey, c.secretKey, c.sessionToken = credentials.AccessKey, credentials.SecretKey, credentials.SessionToken
+	}
+	return c, nil
+}
+
+// environmentCredentials returns the credentials of the AWS environment variables
+func environmentCredentials() *S3Credentials {
+	return &S3Credentials{
+		AccessKey:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+	}
+}
+
+// GetS3Checksums returns the checksums of the objects under the prefix of the bucket by their path in the cache,
+// the full object SHA256 checksum or the ETag when it is the MD5 of the object
+func GetS3Checksums(ctx context.Context, source *appsv1alpha1.S3Source, credentials *S3Credentials, rootCAs *x509.CertPool) (map[string]string, error) {
+	c, err := newS3Client(source, credentials, utils.NewHTTPClient(s3RequestTimeout, rootCAs))
+	if err != nil {
+		return nil, err
+	}
+	objects, err := c.listObjects(ctx, source.Bucket, source.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	checksums := map[string]string{}
+	for _, object := range objects {
+		if strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+		rel, err := getObjectPath(source, object.Key)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.do(ctx, http.MethodHead, source.Bucket, object.Key, nil, http.Header{"X-Amz-Checksum-Mode": {"ENABLED"}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get object %s: %w", object.Key, err)
+		}
+		resp.Body.Close()
+
+		if checksum, err := base64.StdEncoding.DecodeString(resp.Header.Get("X-Amz-Checksum-Sha256")); err == nil && len(checksum) == sha256.Size {
+			checksums[rel] = "sha256:" + hex.EncodeToString(checksum)
+		} else if etag := strings.Trim(object.ETag, `"`); isMD5(etag) && !strings.HasPrefix(resp.Header.Get("X-Amz-Server-Side-Encryption"), "aws:kms") {
+			checksums[rel] = "md5:" + strings.ToLower(etag)
+		}
+	}
+	return checksums, nil
+}
+
+// getObjectPath returns the path of the object relative to the cache path
+func getObjectPath(source *appsv1alpha1.S3Source, key string) (string, error) {
+	rel := strings.TrimPrefix(strings.TrimPrefix(key, source.Prefix), "/")
+	if rel == "" {
+		rel = filepath.Base(key)
+	}
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("object %s is outside of the cache path", key)
+	}
+	return rel, nil
+}
+
+// SyncS3 downloads the objects under the prefix of the bucket into the cache path and verifies their ETag or checksum,
+// the objects already in the cache are not downloaded again
+func SyncS3(ctx context.Context, source *appsv1alpha1.S3Source, path string) (*appsv1alpha1.S3Status, error) {
+	logger := log.FromContext(ctx)
+	// Objects are downloaded for as long as they keep streaming
+	c, err := newS3Client(source, environmentCredentials(), utils.NewHTTPClient(0, nil))
+	if err != nil {
+		return nil, err
+	}
+	objects, err := c.listObjects(ctx, source.Bucket, source.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &appsv1alpha1.S3Status{}
+	for _, object := range objects {
+		// Skip the directory markers
+		if strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+		rel, err := getObjectPath(source, object.Key)
+		if err != nil {
+			return nil, err
+		}
+		dest := filepath.Join(path, rel)
+		etag := strings.Trim(object.ETag, `"`)
+		status.Objects++
+
+		synced, verified, err := isSynced(dest, object.Size, etag)
+		if err != nil {
+			return nil, err
+		}
+		if synced {
+			logger.Info("object already cached", "key", object.Key)
+			if verified {
+				status.VerifiedObjects++
+			}
+			continue
+		}
+
+		n, verified, err := c.downloadObject(ctx, source.Bucket, object.Key, etag, dest)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("object downloaded", "key", object.Key, "bytes", n, "verified", verified)
+		status.BytesTransferred += n
+		if verified {
+			status.VerifiedObjects++
+		}
+	}
+	return status, nil
+}
+
+// isSynced returns whether the file is already cached, and verified against the ETag
+func isSynced(file string, size int64, etag string) (synced bool, verified bool, err error) {
+	info, err := os.Stat(file)
+	if os.IsNotExist(err) {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, err
+	}
+	if info.Size() != size {
+		return false, false, nil
+	}
+	if !isMD5(etag) {
+		return true, false, nil
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return false, false, err
+	}
+	defer f.Close()
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return false, false, err
+	}
+	if hex.EncodeToString(h.Sum(nil)) != etag {
+		return false, false, nil
+	}
+	return true, true, nil
+}
+
+// isMD5 returns whether the ETag is the MD5 of the object, objects uploaded in multiple parts have a -<parts> suffix
+func isMD5(etag string) bool {
+	if len(etag) != md5.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(etag)
+	return err == nil
+}
+
+// listObjects returns all the objects under the prefix of the bucket
+func (c *s3Client) listObjects(ctx context.Context, bucket, prefix string) ([]s3Object, error) {
+	var objects []s3Object
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := c.do(ctx, http.MethodGet, bucket, "", query, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects of bucket %s: %w", bucket, err)
+		}
+		result := &listBucketResult{}
+		err = xml.NewDecoder(resp.Body).Decode(result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode objects of bucket %s: %w", bucket, err)
+		}
+
+		objects = append(objects, result.Contents...)
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// downloadObject downloads the object to the file and verifies its content against the ETag or the SHA256 checksum
+func (c *s3Client) downloadObject(ctx context.Context, bucket, key, etag, file string) (n int64, verified bool, err error) {
+	resp, err := c.do(ctx, http.MethodGet, bucket, key, nil, http.Header{"X-Amz-Checksum-Mode": {"ENABLED"}})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return 0, false, err
+	}
+	partial := file + partialSuffix
+	f, err := os.Create(partial)
+	if err != nil {
+		return 0, false, err
+	}
+	defer os.Remove(partial)
+
+	md5Hash, sha256Hash := md5.New(), sha256.New()
+	n, err = io.Copy(io.MultiWriter(f, md5Hash, sha256Hash), resp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to download object %s: %w", key, err)
+	}
+
+	verified, err = verifyObject(resp.Header, etag, md5Hash, sha256Hash)
+	if err != nil {
+		return 0, false, fmt.Errorf("object %s is corrupted: %w", key, err)
+	}
+	if err := os.Rename(partial, file); err != nil {
+		return 0, false, err
+	}
+	return n, verified, nil
+}
+
+// verifyObject compares the downloaded content with the full object SHA256 checksum, or the ETag when it is the MD5
+// of the object, the ETag of encrypted objects with KMS keys is not the MD5 of the object
+func verifyObject(header http.Header, etag string, md5Hash, sha256Hash hash.Hash) (bool, error) {
+	if checksum := header.Get("X-Amz-Checksum-Sha256"); checksum != "" && !strings.Contains(checksum, "-") {
+		if actual := base64.StdEncoding.EncodeToString(sha256Hash.Sum(nil)); actual != checksum {
+			return false, fmt.Errorf("sha256 checksum %s does not match %s", actual, checksum)
+		}
+		return true, nil
+	}
+	if isMD5(etag) && !strings.HasPrefix(header.Get("X-Amz-Server-Side-Encryption"), "aws:kms") {
+		if actual := hex.EncodeToString(md5Hash.Sum(nil)); actual != etag {
+			return false, fmt.Errorf("md5 %s does not match the etag %s", actual, etag)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// do sends a signed request for the key of the bucket, or the bucket itself when the key is empty
+func (c *s3Client) do(ctx context.Context, method, bucket, key string, query url.Values, header http.Header) (*http.Response, error) {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	c.sign(req, time.Now())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return resp, nil
+}
+
+// sign signs the request with AWS Signature Version 4, requests without credentials are sent anonymously
+func (c *s3Client) sign(req *http.Request, now time.Time) {
+	if c.accessKey == "" || c.secretKey == "" {
+		return
+	}
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	if c.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", c.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		emptyPayloadHash,
+	}, "\n")
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, c.region)
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.secretKey), date)
+	key = hmacSHA256(key, c.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.accessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery returns the query string sorted by key with the values encoded as required by the signature
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// uriEncode percent-encodes all the characters but the unreserved ones, and the slashes unless encodeSlash is set
+func uriEncode(s string, encodeSlash bool) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			sb.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
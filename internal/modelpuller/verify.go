@@ -0,0 +1,239 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpuller
+
+import (
+	"bufio"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// ChecksumsPath is the directory the checksums ConfigMap is mounted in, one file per profile
+	ChecksumsPath = "/etc/nim/checksums"
+
+	// maxFailedFiles is the number of failed files reported per profile, keeping the report within
+	// the size limit of the termination message
+	maxFailedFiles = 10
+)
+
+// FileChecksum is the expected checksum of a cached file
+type FileChecksum struct {
+	// Path is the path of the file relative to the cache
+	Path string
+	// Checksum is the checksum of the file prefixed with its algorithm, sha256, md5 or gitsha1
+	Checksum string
+}
+
+// FormatChecksums formats the checksums of the files as the content of a checksums file, sorted by path
+func FormatChecksums(checksums map[string]string) string {
+	paths := make([]string, 0, len(checksums))
+	for path := range checksums {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var b strings.Builder
+	for _, path := range paths {
+		fmt.Fprintf(&b, "%s  %s\n", checksums[path], path)
+	}
+	return b.String()
+}
+
+// ParseChecksums parses a checksums file with one "<algorithm>:<checksum>  <path>" line per file, the
+// checksums without algorithm are sha256 checksums as written by sha256sum
+func ParseChecksums(r io.Reader) ([]FileChecksum, error) {
+	var checksums []FileChecksum
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		checksum, path, found := strings.Cut(line, " ")
+		// sha256sum separates the path with a space and a text or binary mode marker
+		path = strings.TrimPrefix(strings.TrimPrefix(path, " "), "*")
+		if !found || path == "" {
+			return nil, fmt.Errorf("invalid checksum line %q", line)
+		}
+		if !strings.Contains(checksum, ":") {
+			checksum = "sha256:" + checksum
+		}
+		checksum = strings.ToLower(checksum)
+		if algorithm, _, _ := strings.Cut(checksum, ":"); algorithm != "sha256" && algorithm != "md5" && algorithm != "gitsha1" {
+			return nil, fmt.Errorf("unsupported checksum algorithm %s", algorithm)
+		}
+		checksums = append(checksums, FileChecksum{Path: path, Checksum: checksum})
+	}
+	return checksums, scanner.Err()
+}
+
+// Verify verifies the files of the cache path against the checksums files of the given directory, each file
+// holding the checksums of a profile
+func Verify(ctx context.Context, checksumsPath, path string) ([]appsv1alpha1.ProfileVerification, error) {
+	logger := log.FromContext(ctx)
+	entries, err := os.ReadDir(checksumsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []appsv1alpha1.ProfileVerification
+	for _, entry := range entries {
+		// skip the data directories of the mounted ConfigMap
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		result, err := verifyProfile(ctx, filepath.Join(checksumsPath, entry.Name()), path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify profile %s: %w", entry.Name(), err)
+		}
+		result.Profile = entry.Name()
+		logger.Info("profile verified", "profile", result.Profile, "files", result.Files, "verified", result.VerifiedFiles)
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// VerifyBlobs verifies the content addressed blobs of the cache path against their names, the NIM cache layout
+// names the blobs downloaded from NGC after the checksum of their content
+func VerifyBlobs(ctx context.Context, path string) (*appsv1alpha1.ProfileVerification, error) {
+	checksums, err := getBlobChecksums(path)
+	if err != nil {
+		return nil, err
+	}
+	result, err := verifyFiles(ctx, checksums, path)
+	if err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).Info("blobs verified", "files", result.Files, "verified", result.VerifiedFiles)
+	return result, nil
+}
+
+// getBlobChecksums returns the checksums of the files of the blobs directories named after an md5, git sha1 or
+// sha256 checksum, sorted by path
+func getBlobChecksums(path string) ([]FileChecksum, error) {
+	algorithms := map[int]string{md5.Size * 2: "md5", sha1.Size * 2: "gitsha1", sha256.Size * 2: "sha256"}
+	var checksums []FileChecksum
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() || filepath.Base(filepath.Dir(p)) != "blobs" {
+			return nil
+		}
+		algorithm, found := algorithms[len(d.Name())]
+		if _, err := hex.DecodeString(d.Name()); !found || err != nil {
+			return nil
+		}
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return err
+		}
+		checksums = append(checksums, FileChecksum{Path: rel, Checksum: algorithm + ":" + strings.ToLower(d.Name())})
+		return nil
+	})
+	return checksums, err
+}
+
+// verifyProfile verifies the cached files listed in the checksums file
+func verifyProfile(ctx context.Context, checksumsFile, path string) (*appsv1alpha1.ProfileVerification, error) {
+	f, err := os.Open(checksumsFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	checksums, err := ParseChecksums(f)
+	if err != nil {
+		return nil, err
+	}
+	return verifyFiles(ctx, checksums, path)
+}
+
+// verifyFiles verifies the cached files against their checksum
+func verifyFiles(ctx context.Context, checksums []FileChecksum, path string) (*appsv1alpha1.ProfileVerification, error) {
+	result := &appsv1alpha1.ProfileVerification{Files: int64(len(checksums))}
+	for _, expected := range checksums {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if !filepath.IsLocal(expected.Path) {
+			return nil, fmt.Errorf("file %s is outside of the cache path", expected.Path)
+		}
+		algorithm, _, _ := strings.Cut(expected.Checksum, ":")
+		checksum, err := computeChecksum(filepath.Join(path, expected.Path), algorithm)
+		switch {
+		case os.IsNotExist(err):
+		case err != nil:
+			return nil, err
+		case checksum == expected.Checksum:
+			result.VerifiedFiles++
+			continue
+		}
+		log.FromContext(ctx).Info("file does not match its checksum", "file", expected.Path, "expected", expected.Checksum, "actual", checksum)
+		if len(result.FailedFiles) < maxFailedFiles {
+			result.FailedFiles = append(result.FailedFiles, expected.Path)
+		}
+	}
+	return result, nil
+}
+
+// computeChecksum returns the checksum of the file with the given algorithm, prefixed with the algorithm
+func computeChecksum(file, algorithm string) (string, error) {
+	var h hash.Hash
+	switch algorithm {
+	case "sha256":
+		h = sha256.New()
+	case "md5":
+		h = md5.New()
+	case "gitsha1":
+		h = sha1.New()
+	default:
+		return "", fmt.Errorf("unsupported checksum algorithm %s", algorithm)
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if algorithm == "gitsha1" {
+		info, err := f.Stat()
+		if err != nil {
+			return "", err
+		}
+		// git hashes the blob header with the content
+		fmt.Fprintf(h, "blob %d\x00", info.Size())
+	}
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return algorithm + ":" + hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpuller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseChecksums(t *testing.T) {
+	checksums, err := ParseChecksums(strings.NewReader(`# profile checksums
+sha256:ABCD  config.json
+0123 *model.safetensors
+
+md5:4567  tokenizer/tokenizer.json
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, []FileChecksum{
+		{Path: "config.json", Checksum: "sha256:abcd"},
+		{Path: "model.safetensors", Checksum: "sha256:0123"},
+		{Path: "tokenizer/tokenizer.json", Checksum: "md5:4567"},
+	}, checksums)
+
+	_, err = ParseChecksums(strings.NewReader("sha256:abcd"))
+	assert.ErrorContains(t, err, "invalid checksum line")
+	_, err = ParseChecksums(strings.NewReader("crc32:abcd  config.json"))
+	assert.ErrorContains(t, err, "unsupported checksum algorithm crc32")
+
+	assert.Equal(t, "sha256:abcd  a.json\nmd5:0123  b/c.bin\n", FormatChecksums(map[string]string{
+		"b/c.bin": "md5:0123",
+		"a.json":  "sha256:abcd",
+	}))
+}
+
+func TestVerify(t *testing.T) {
+	path := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(path, "tokenizer"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "config.json"), []byte("hello\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "tokenizer", "tokenizer.json"), []byte("corrupted"), 0644))
+
+	checksumsPath := t.TempDir()
+	// the data directory of a mounted ConfigMap is skipped
+	assert.NoError(t, os.MkdirAll(filepath.Join(checksumsPath, "..data"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(checksumsPath, "huggingface"), []byte(
+		// git hash-object of "hello\n"
+		"gitsha1:ce013625030ba8dba906f756967f9e9ca394464a  config.json\n"+
+			"md5:b1946ac92492d2347c6235b4d2611184  config.json\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(checksumsPath, "profile"), []byte(
+		"5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03  config.json\n"+
+			"sha256:5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03  tokenizer/tokenizer.json\n"+
+			"sha256:5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03  model.safetensors\n"), 0644))
+
+	results, err := Verify(context.TODO(), checksumsPath, path)
+	assert.NoError(t, err)
+	assert.Equal(t, []appsv1alpha1.ProfileVerification{
+		{Profile: "huggingface", Files: 2, VerifiedFiles: 2},
+		{Profile: "profile", Files: 3, VerifiedFiles: 1, FailedFiles: []string{"tokenizer/tokenizer.json", "model.safetensors"}},
+	}, results)
+
+	// files outside of the cache are rejected
+	assert.NoError(t, os.WriteFile(filepath.Join(checksumsPath, "profile"), []byte("sha256:abcd  ../etc/passwd\n"), 0644))
+	_, err = Verify(context.TODO(), checksumsPath, path)
+	assert.ErrorContains(t, err, "outside of the cache path")
+}
+
+func TestVerifyBlobs(t *testing.T) {
+	path := t.TempDir()
+	blobs := filepath.Join(path, "ngc", "hub", "models--nim--meta--llama3-8b-instruct", "blobs")
+	assert.NoError(t, os.MkdirAll(blobs, 0755))
+	// sha256, md5 and git sha1 of "hello\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(blobs, "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03"), []byte("hello\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(blobs, "b1946ac92492d2347c6235b4d2611184"), []byte("hello\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(blobs, "ce013625030ba8dba906f756967f9e9ca394464a"), []byte("corrupted"), 0644))
+	// files not named after a checksum are skipped
+	assert.NoError(t, os.WriteFile(filepath.Join(blobs, "config.json.lock"), []byte(""), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "b1946ac92492d2347c6235b4d2611184"), []byte("other"), 0644))
+
+	result, err := VerifyBlobs(context.TODO(), path)
+	assert.NoError(t, err)
+	assert.Equal(t, &appsv1alpha1.ProfileVerification{
+		Files:         3,
+		VerifiedFiles: 2,
+		FailedFiles:   []string{"ngc/hub/models--nim--meta--llama3-8b-instruct/blobs/ce013625030ba8dba906f756967f9e9ca394464a"},
+	}, result)
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package nimparser
 
 import (
+	"path"
+	"strings"
+
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 )
 
@@ -40,4 +43,19 @@ type NIMManifestInterface interface {
 	GetProfileModel(profileID string) string
 	GetProfileTags(profileID string) map[string]string
 	GetProfileRelease(profileID string) string
+	GetProfileFiles(profileID string) []string
+}
+
+// GetNGCCachePath returns the path of a file of an NGC model repository in the NIM cache, the files of the
+// ngc://<org>/<team>/<model>:<version> repository are linked in the snapshot of the version
+func GetNGCCachePath(repoID, file string) (string, bool) {
+	repo, found := strings.CutPrefix(repoID, "ngc://")
+	if !found {
+		return "", false
+	}
+	model, version, found := strings.Cut(repo, ":")
+	if !found || model == "" || version == "" || file == "" {
+		return "", false
+	}
+	return path.Join("ngc", "hub", "models--"+strings.ReplaceAll(model, "/", "--"), "snapshots", version, file), true
 }
@@ -106,6 +106,11 @@ func (f *File) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// MarshalYAML marshals the file as its name, as listed in the manifest
+func (f File) MarshalYAML() (interface{}, error) {
+	return f.Name, nil
+}
+
 func (manifest NIMManifest) MatchProfiles(modelSpec appsv1alpha1.ModelSpec, discoveredGPUs []string) ([]string, error) {
 	//TODO implement me
 	var selectedProfiles []string
@@ -170,6 +175,20 @@ func (manifest NIMManifest) GetProfileRelease(profileID string) string {
 	return manifest[profileID].Release
 }
 
+// GetProfileFiles returns the paths of the files of the profile in the NIM cache, the files of the repositories
+// other than NGC are not listed
+func (manifest NIMManifest) GetProfileFiles(profileID string) []string {
+	var files []string
+	for _, component := range manifest[profileID].Workspace.Components {
+		for _, file := range component.Src.Files {
+			if p, ok := nimparser.GetNGCCachePath(component.Src.RepoID, file.Name); ok {
+				files = append(files, p)
+			}
+		}
+	}
+	return files
+}
+
 func isOptimizedEngine(engine string) bool {
 	return engine != "" && strings.Contains(strings.ToLower(engine), BackendTypeTensorRT)
 }
@@ -17,8 +17,10 @@ limitations under the License.
 package v2
 
 import (
+	"net/url"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -139,6 +141,29 @@ func (manifest NIMManifest) GetProfileRelease(profileID string) string {
 	return ""
 }
 
+// GetProfileFiles returns the paths of the files of the profile in the NIM cache, the files of the repositories
+// other than NGC are not listed
+func (manifest NIMManifest) GetProfileFiles(profileID string) []string {
+	var files []string
+	for _, profile := range manifest.Profiles {
+		if profileID != profile.ID {
+			continue
+		}
+		for name, file := range profile.Workspace.Files {
+			// the uri names the file of the repository, e.g. ngc://nim/meta/llama3-8b-instruct:hf?file=config.json
+			repoID, query, _ := strings.Cut(file.Uri, "?")
+			if values, err := url.ParseQuery(query); err == nil && values.Get("file") != "" {
+				name = values.Get("file")
+			}
+			if p, ok := nimparser.GetNGCCachePath(repoID, name); ok {
+				files = append(files, p)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
 func isOptimizedEngine(engine string) bool {
 	return engine != "" && strings.Contains(strings.ToLower(engine), BackendTypeTensorRT)
 }
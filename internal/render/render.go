@@ -42,6 +42,7 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -70,7 +71,18 @@ type Renderer interface {
 	SCC(params *types.SCCParams) (*securityv1.SecurityContextConstraints, error)
 	Ingress(params *types.IngressParams) (*networkingv1.Ingress, error)
 	HPA(params *types.HPAParams) (*autoscalingv2.HorizontalPodAutoscaler, error)
+	PodDisruptionBudget(params *types.PDBParams) (*policyv1.PodDisruptionBudget, error)
+	NetworkPolicy(params *types.NetworkPolicyParams) (*networkingv1.NetworkPolicy, error)
+	ConfigMap(params *types.ConfigMapParams) (*corev1.ConfigMap, error)
 	ServiceMonitor(params *types.ServiceMonitorParams) (*monitoringv1.ServiceMonitor, error)
+	LeaderWorkerSet(params *types.LeaderWorkerSetParams) (*unstructured.Unstructured, error)
+	ScaledObject(params *types.ScaledObjectParams) (*unstructured.Unstructured, error)
+	HTTPRoute(params *types.GatewayRouteParams) (*unstructured.Unstructured, error)
+	GRPCRoute(params *types.GatewayRouteParams) (*unstructured.Unstructured, error)
+	Certificate(params *types.CertificateParams) (*unstructured.Unstructured, error)
+	ServingRuntime(params *types.ServingRuntimeParams) (*unstructured.Unstructured, error)
+	InferenceService(params *types.InferenceServiceParams) (*unstructured.Unstructured, error)
+	LocalModelCache(params *types.LocalModelCacheParams) (*unstructured.Unstructured, error)
 }
 
 // TemplateData is used by the templating engine to render templates
@@ -136,6 +148,12 @@ func (r *textTemplateRenderer) renderFile(filePath string, data *TemplateData) (
 			}
 			return *b
 		},
+		// include renders a named template defined in the manifest so that its output can be piped
+		"include": func(name string, data interface{}) (string, error) {
+			buf := bytes.Buffer{}
+			err := tmpl.ExecuteTemplate(&buf, name, data)
+			return buf.String(), err
+		},
 	})
 
 	if data.Funcs != nil {
@@ -353,6 +371,57 @@ func (r *textTemplateRenderer) HPA(params *types.HPAParams) (*autoscalingv2.Hori
 	return hpa, nil
 }
 
+// PodDisruptionBudget renders spec for a PodDisruptionBudget with the given templating data
+func (r *textTemplateRenderer) PodDisruptionBudget(params *types.PDBParams) (*policyv1.PodDisruptionBudget, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "pdb.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	pdb := &policyv1.PodDisruptionBudget{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(objs[0].Object, pdb)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unstructured object to PodDisruptionBudget: %w", err)
+	}
+	return pdb, nil
+}
+
+// NetworkPolicy renders spec for a NetworkPolicy with the given templating data
+func (r *textTemplateRenderer) NetworkPolicy(params *types.NetworkPolicyParams) (*networkingv1.NetworkPolicy, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "networkpolicy.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(objs[0].Object, networkPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unstructured object to NetworkPolicy: %w", err)
+	}
+	return networkPolicy, nil
+}
+
+// ConfigMap renders spec for a ConfigMap with the given templating data
+func (r *textTemplateRenderer) ConfigMap(params *types.ConfigMapParams) (*corev1.ConfigMap, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "configmap.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	configMap := &corev1.ConfigMap{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(objs[0].Object, configMap)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unstructured object to ConfigMap: %w", err)
+	}
+	return configMap, nil
+}
+
 // ServiceMonitor renders spec for a ServiceMonitor with the given templating data
 func (r *textTemplateRenderer) ServiceMonitor(params *types.ServiceMonitorParams) (*monitoringv1.ServiceMonitor, error) {
 	objs, err := r.renderFile(path.Join(r.directory, "servicemonitor.yaml"), &TemplateData{Data: params})
@@ -369,3 +438,99 @@ func (r *textTemplateRenderer) ServiceMonitor(params *types.ServiceMonitorParams
 	}
 	return serviceMonitor, nil
 }
+
+// LeaderWorkerSet renders spec for a LeaderWorkerSet with the given templating data
+func (r *textTemplateRenderer) LeaderWorkerSet(params *types.LeaderWorkerSetParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "leaderworkerset.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+// ScaledObject renders spec for a KEDA ScaledObject with the given templating data
+func (r *textTemplateRenderer) ScaledObject(params *types.ScaledObjectParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "scaledobject.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+// HTTPRoute renders spec for a Gateway API HTTPRoute with the given templating data
+func (r *textTemplateRenderer) HTTPRoute(params *types.GatewayRouteParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "httproute.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+// GRPCRoute renders spec for a Gateway API GRPCRoute with the given templating data
+func (r *textTemplateRenderer) GRPCRoute(params *types.GatewayRouteParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "grpcroute.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+// Certificate renders spec for a cert-manager Certificate with the given templating data
+func (r *textTemplateRenderer) Certificate(params *types.CertificateParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "certificate.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+// ServingRuntime renders spec for a KServe ServingRuntime with the given templating data
+func (r *textTemplateRenderer) ServingRuntime(params *types.ServingRuntimeParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "servingruntime.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+// InferenceService renders spec for a KServe InferenceService with the given templating data
+func (r *textTemplateRenderer) InferenceService(params *types.InferenceServiceParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "inferenceservice.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+// LocalModelCache renders spec for a KServe LocalModelCache with the given templating data
+func (r *textTemplateRenderer) LocalModelCache(params *types.LocalModelCacheParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "localmodelcache.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
@@ -27,7 +27,10 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	"github.com/NVIDIA/k8s-nim-operator/internal/render"
 	"github.com/NVIDIA/k8s-nim-operator/internal/render/types"
@@ -183,6 +186,92 @@ var _ = Describe("K8s Resources Rendering", func() {
 			Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts[0].Name).To(Equal("test-volume"))
 			Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts[0].MountPath).To(Equal("/data"))
 			Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts[0].SubPath).To(Equal("subPath"))
+			Expect(deployment.Spec.Template.Spec.ResourceClaims).To(BeEmpty())
+			Expect(deployment.Spec.Template.Spec.Affinity).To(BeNil())
+		})
+
+		It("should render Deployment template with affinity and topology spread constraints correctly", func() {
+			params := types.DeploymentParams{
+				Name:          "test-deployment",
+				Namespace:     "default",
+				ContainerName: "test-container",
+				Image:         "nim-llm:latest",
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "nvidia.com/gpu.product", Operator: corev1.NodeSelectorOpIn, Values: []string{"NVIDIA-H100-80GB-HBM3"}}}},
+							},
+						},
+					},
+					PodAntiAffinity: &corev1.PodAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+							{Weight: 10, PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: "kubernetes.io/hostname"}},
+						},
+					},
+				},
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1.ScheduleAnyway,
+						LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test-app"}},
+					},
+				},
+			}
+
+			r := render.NewRenderer(templatesDir)
+			deployment, err := r.Deployment(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment.Spec.Template.Spec.Affinity).To(Equal(params.Affinity))
+			Expect(deployment.Spec.Template.Spec.TopologySpreadConstraints).To(Equal(params.TopologySpreadConstraints))
+		})
+
+		It("should render Deployment template with resource claims correctly", func() {
+			params := types.DeploymentParams{
+				Name:          "test-deployment",
+				Namespace:     "default",
+				ContainerName: "test-container",
+				Image:         "nim-llm:latest",
+				Resources: &corev1.ResourceRequirements{
+					Claims: []corev1.ResourceClaim{{Name: "gpu"}},
+				},
+				ResourceClaims: []corev1.PodResourceClaim{
+					{Name: "gpu", ResourceClaimTemplateName: ptr.To("test-deployment-gpu")},
+				},
+			}
+
+			r := render.NewRenderer(templatesDir)
+			deployment, err := r.Deployment(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment.Spec.Template.Spec.ResourceClaims).To(Equal(params.ResourceClaims))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Resources.Claims).To(Equal([]corev1.ResourceClaim{{Name: "gpu"}}))
+		})
+
+		It("should render Deployment and StatefulSet templates with secret volumes correctly", func() {
+			volumes := []corev1.Volume{
+				{
+					Name: "tls",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: "test-tls",
+							Items:      []corev1.KeyToPath{{Key: "tls.crt", Path: "tls.crt"}},
+						},
+					},
+				},
+			}
+
+			r := render.NewRenderer(templatesDir)
+			deployment, err := r.Deployment(&types.DeploymentParams{Name: "test-deployment", Namespace: "default", Volumes: volumes})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment.Spec.Template.Spec.Volumes[0].VolumeSource.Secret.SecretName).To(Equal("test-tls"))
+			Expect(deployment.Spec.Template.Spec.Volumes[0].VolumeSource.Secret.Items).To(Equal(volumes[0].Secret.Items))
+			Expect(deployment.Spec.Template.Spec.Volumes[0].VolumeSource.EmptyDir).To(BeNil())
+
+			statefulSet, err := r.StatefulSet(&types.StatefulSetParams{Name: "test-statefulset", Namespace: "default", Volumes: volumes})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statefulSet.Spec.Template.Spec.Volumes[0].VolumeSource.Secret.SecretName).To(Equal("test-tls"))
+			Expect(statefulSet.Spec.Template.Spec.Volumes[0].VolumeSource.Secret.Items).To(Equal(volumes[0].Secret.Items))
 		})
 
 		It("should render StatefulSet template correctly", func() {
@@ -336,6 +425,63 @@ var _ = Describe("K8s Resources Rendering", func() {
 			Expect(ingress.Namespace).To(Equal("default"))
 		})
 
+		It("should render PodDisruptionBudget template correctly", func() {
+			minAvailable := intstr.FromString("50%")
+			params := types.PDBParams{
+				Enabled:        true,
+				Name:           "test-pdb",
+				Namespace:      "default",
+				SelectorLabels: map[string]string{"app": "test-app"},
+				MinAvailable:   &minAvailable,
+			}
+			r := render.NewRenderer(templatesDir)
+			pdb, err := r.PodDisruptionBudget(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pdb.Name).To(Equal("test-pdb"))
+			Expect(pdb.Namespace).To(Equal("default"))
+			Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue("app", "test-app"))
+			Expect(*pdb.Spec.MinAvailable).To(Equal(intstr.FromString("50%")))
+			Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+
+			maxUnavailable := intstr.FromInt32(1)
+			params.MinAvailable = nil
+			params.MaxUnavailable = &maxUnavailable
+			pdb, err = r.PodDisruptionBudget(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pdb.Spec.MinAvailable).To(BeNil())
+			Expect(*pdb.Spec.MaxUnavailable).To(Equal(intstr.FromInt32(1)))
+		})
+
+		It("should render NetworkPolicy template correctly", func() {
+			port := intstr.FromInt32(8000)
+			params := types.NetworkPolicyParams{
+				Enabled:        true,
+				Name:           "test-networkpolicy",
+				Namespace:      "default",
+				SelectorLabels: map[string]string{"app": "test-app"},
+				Ingress: []v1.NetworkPolicyIngressRule{
+					{
+						Ports: []v1.NetworkPolicyPort{{Port: &port}},
+						From:  []v1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}},
+					},
+				},
+				Egress: []v1.NetworkPolicyEgressRule{
+					{
+						To: []v1.NetworkPolicyPeer{{IPBlock: &v1.IPBlock{CIDR: "10.0.0.1/32"}}},
+					},
+				},
+			}
+			r := render.NewRenderer(templatesDir)
+			networkPolicy, err := r.NetworkPolicy(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(networkPolicy.Name).To(Equal("test-networkpolicy"))
+			Expect(networkPolicy.Namespace).To(Equal("default"))
+			Expect(networkPolicy.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue("app", "test-app"))
+			Expect(networkPolicy.Spec.PolicyTypes).To(ConsistOf(v1.PolicyTypeIngress, v1.PolicyTypeEgress))
+			Expect(networkPolicy.Spec.Ingress).To(Equal(params.Ingress))
+			Expect(networkPolicy.Spec.Egress).To(Equal(params.Egress))
+		})
+
 		It("should render HPA template correctly", func() {
 			minRep := int32(1)
 			params := types.HPAParams{
@@ -405,5 +551,291 @@ var _ = Describe("K8s Resources Rendering", func() {
 			Expect(hpa.Spec.Metrics[0].Type).To(Equal(autoscalingv2.PodsMetricSourceType))
 			Expect(hpa.Spec.Metrics[1].Type).To(Equal(autoscalingv2.ResourceMetricSourceType))
 		})
+
+		It("should render StatefulSet template with volume claim templates correctly", func() {
+			params := types.StatefulSetParams{
+				Name:          "test-statefulset",
+				Namespace:     "default",
+				Replicas:      2,
+				ServiceName:   "test-service",
+				ContainerName: "test-container",
+				Image:         "nim-llm:latest",
+				VolumeMounts:  []corev1.VolumeMount{{Name: "engine-cache", MountPath: "/engine-cache"}},
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+					ObjectMeta: metav1.ObjectMeta{Name: "engine-cache"},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+						},
+					},
+				}},
+			}
+			r := render.NewRenderer(templatesDir)
+			statefulSet, err := r.StatefulSet(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statefulSet.Name).To(Equal("test-statefulset"))
+			Expect(*statefulSet.Spec.Replicas).To(Equal(int32(2)))
+			Expect(statefulSet.Spec.ServiceName).To(Equal("test-service"))
+			Expect(statefulSet.Spec.VolumeClaimTemplates).To(HaveLen(1))
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Name).To(Equal("engine-cache"))
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceStorage, resource.MustParse("10Gi")))
+			Expect(statefulSet.Spec.Template.Spec.Containers[0].VolumeMounts).To(ConsistOf(corev1.VolumeMount{Name: "engine-cache", MountPath: "/engine-cache"}))
+		})
+
+		It("should render LeaderWorkerSet template correctly", func() {
+			params := types.LeaderWorkerSetParams{
+				Name:          "test-lws",
+				Namespace:     "default",
+				Replicas:      2,
+				Size:          3,
+				ContainerName: "test-container",
+				Image:         "nim-llm:latest",
+				Port:          8000,
+				LeaderEnv:     []corev1.EnvVar{{Name: "NIM_LEADER_ROLE", Value: "1"}},
+				WorkerEnv:     []corev1.EnvVar{{Name: "NIM_LEADER_ROLE", Value: "0"}},
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/v1/health/ready"}},
+				},
+				Resources: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+				},
+			}
+			r := render.NewRenderer(templatesDir)
+			lws, err := r.LeaderWorkerSet(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lws.GetKind()).To(Equal("LeaderWorkerSet"))
+			Expect(lws.GetName()).To(Equal("test-lws"))
+			replicas, _, _ := unstructured.NestedInt64(lws.Object, "spec", "replicas")
+			Expect(replicas).To(Equal(int64(2)))
+			size, _, _ := unstructured.NestedInt64(lws.Object, "spec", "leaderWorkerTemplate", "size")
+			Expect(size).To(Equal(int64(3)))
+			leader, _, _ := unstructured.NestedSlice(lws.Object, "spec", "leaderWorkerTemplate", "leaderTemplate", "spec", "containers")
+			Expect(leader).To(HaveLen(1))
+			Expect(leader[0].(map[string]interface{})["env"]).To(ConsistOf(map[string]interface{}{"name": "NIM_LEADER_ROLE", "value": "1"}))
+			Expect(leader[0].(map[string]interface{})).To(HaveKey("readinessProbe"))
+			Expect(leader[0].(map[string]interface{})).To(HaveKey("ports"))
+			worker, _, _ := unstructured.NestedSlice(lws.Object, "spec", "leaderWorkerTemplate", "workerTemplate", "spec", "containers")
+			Expect(worker).To(HaveLen(1))
+			Expect(worker[0].(map[string]interface{})["env"]).To(ConsistOf(map[string]interface{}{"name": "NIM_LEADER_ROLE", "value": "0"}))
+			Expect(worker[0].(map[string]interface{})).NotTo(HaveKey("readinessProbe"))
+			gpuLimit, _, _ := unstructured.NestedString(worker[0].(map[string]interface{}), "resources", "limits", "nvidia.com/gpu")
+			Expect(gpuLimit).To(Equal("8"))
+		})
+
+		It("should render ConfigMap template correctly", func() {
+			params := types.ConfigMapParams{
+				Name:      "test-configmap",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "test-app"},
+				Data:      map[string]string{"config.yaml": "rules:\n- seriesQuery: num_requests_waiting\n"},
+			}
+			r := render.NewRenderer(templatesDir)
+			configMap, err := r.ConfigMap(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configMap.Name).To(Equal("test-configmap"))
+			Expect(configMap.Namespace).To(Equal("default"))
+			Expect(configMap.Labels).To(HaveKeyWithValue("app", "test-app"))
+			Expect(configMap.Data).To(Equal(params.Data))
+		})
+
+		It("should render ScaledObject template correctly", func() {
+			params := types.ScaledObjectParams{
+				Name:            "test-scaledobject",
+				Namespace:       "default",
+				ScaleTargetKind: "Deployment",
+				ScaleTargetAPI:  "apps/v1",
+				MinReplicas:     ptr.To[int32](0),
+				MaxReplicas:     5,
+				CooldownPeriod:  ptr.To[int32](300),
+				Triggers: []types.ScaledObjectTrigger{
+					{
+						Type:       "prometheus",
+						MetricType: "AverageValue",
+						Metadata:   map[string]string{"serverAddress": "http://prometheus:9090", "query": "sum(num_requests_waiting)", "threshold": "10"},
+					},
+				},
+			}
+			r := render.NewRenderer(templatesDir)
+			scaledObject, err := r.ScaledObject(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scaledObject.GetKind()).To(Equal("ScaledObject"))
+			Expect(scaledObject.GetName()).To(Equal("test-scaledobject"))
+			name, _, _ := unstructured.NestedString(scaledObject.Object, "spec", "scaleTargetRef", "name")
+			Expect(name).To(Equal("test-scaledobject"))
+			minReplicas, found, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "minReplicaCount")
+			Expect(found).To(BeTrue())
+			Expect(minReplicas).To(Equal(int64(0)))
+			cooldown, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "cooldownPeriod")
+			Expect(cooldown).To(Equal(int64(300)))
+			Expect(scaledObject.Object["spec"]).NotTo(HaveKey("pollingInterval"))
+			triggers, _, _ := unstructured.NestedSlice(scaledObject.Object, "spec", "triggers")
+			Expect(triggers).To(HaveLen(1))
+			Expect(triggers[0]).To(HaveKeyWithValue("type", "prometheus"))
+			Expect(triggers[0]).To(HaveKeyWithValue("metadata", HaveKeyWithValue("query", "sum(num_requests_waiting)")))
+		})
+
+		It("should render Gateway API route templates correctly", func() {
+			params := types.GatewayRouteParams{
+				Enabled:    true,
+				Name:       "test-route",
+				Namespace:  "default",
+				ParentRefs: []types.GatewayParentRef{{Name: "test-gateway", Namespace: "gateway-system"}},
+				Hostnames:  []string{"nim.example.com"},
+				Rules: []types.HTTPRouteRule{
+					{
+						Matches:     []types.HTTPRouteMatch{{Path: &types.HTTPPathMatch{Type: "PathPrefix", Value: "/v1/chat"}}},
+						BackendRefs: []types.GatewayBackendRef{{Name: "test-service", Port: 8000, Weight: ptr.To[int32](90)}},
+					},
+				},
+				GRPCBackendRef: types.GatewayBackendRef{Name: "test-service", Port: 8000},
+			}
+			r := render.NewRenderer(templatesDir)
+			httpRoute, err := r.HTTPRoute(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpRoute.GetKind()).To(Equal("HTTPRoute"))
+			Expect(httpRoute.GetName()).To(Equal("test-route"))
+			parentRefs, _, _ := unstructured.NestedSlice(httpRoute.Object, "spec", "parentRefs")
+			Expect(parentRefs).To(Equal([]interface{}{map[string]interface{}{"name": "test-gateway", "namespace": "gateway-system"}}))
+			hostnames, _, _ := unstructured.NestedStringSlice(httpRoute.Object, "spec", "hostnames")
+			Expect(hostnames).To(Equal([]string{"nim.example.com"}))
+			rules, _, _ := unstructured.NestedSlice(httpRoute.Object, "spec", "rules")
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0]).To(HaveKeyWithValue("backendRefs", ConsistOf(HaveKeyWithValue("weight", int64(90)))))
+
+			// The GRPCRoute is only rendered when enabled
+			grpcRoute, err := r.GRPCRoute(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(grpcRoute).To(BeNil())
+			params.GRPCEnabled = true
+			grpcRoute, err = r.GRPCRoute(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(grpcRoute.GetKind()).To(Equal("GRPCRoute"))
+			rules, _, _ = unstructured.NestedSlice(grpcRoute.Object, "spec", "rules")
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0]).To(HaveKeyWithValue("backendRefs", ConsistOf(HaveKeyWithValue("name", "test-service"))))
+		})
+
+		It("should render Certificate template correctly", func() {
+			params := types.CertificateParams{
+				Name:        "test-service",
+				Namespace:   "default",
+				SecretName:  "test-service-tls",
+				DNSNames:    []string{"test-service", "test-service.default.svc"},
+				IssuerName:  "test-issuer",
+				RenewBefore: "360h0m0s",
+			}
+			r := render.NewRenderer(templatesDir)
+			certificate, err := r.Certificate(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificate.GetKind()).To(Equal("Certificate"))
+			Expect(certificate.GetName()).To(Equal("test-service"))
+			secretName, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+			Expect(secretName).To(Equal("test-service-tls"))
+			dnsNames, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "dnsNames")
+			Expect(dnsNames).To(Equal([]string{"test-service", "test-service.default.svc"}))
+			issuerKind, _, _ := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "kind")
+			Expect(issuerKind).To(Equal("Issuer"))
+			_, found, _ := unstructured.NestedString(certificate.Object, "spec", "duration")
+			Expect(found).To(BeFalse())
+			renewBefore, _, _ := unstructured.NestedString(certificate.Object, "spec", "renewBefore")
+			Expect(renewBefore).To(Equal("360h0m0s"))
+		})
+
+		It("should render ServingRuntime template correctly", func() {
+			params := types.ServingRuntimeParams{
+				Name:          "test-runtime",
+				Namespace:     "default",
+				ModelFormat:   "nvidia-nim-test",
+				ContainerName: "kserve-container",
+				Image:         "nim-llm:latest",
+				Port:          8000,
+				Env:           []corev1.EnvVar{{Name: "NIM_CACHE_PATH", Value: "/model-store"}},
+				VolumeMounts:  []corev1.VolumeMount{{Name: "model-store", MountPath: "/model-store"}},
+				Volumes: []corev1.Volume{
+					{
+						Name: "model-store",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "test-pvc"},
+						},
+					},
+				},
+				ImagePullSecrets: []string{"ngc-secret"},
+			}
+			r := render.NewRenderer(templatesDir)
+			runtime, err := r.ServingRuntime(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runtime.GetKind()).To(Equal("ServingRuntime"))
+			Expect(runtime.GetName()).To(Equal("test-runtime"))
+			Expect(runtime.GetNamespace()).To(Equal("default"))
+			formats, _, _ := unstructured.NestedSlice(runtime.Object, "spec", "supportedModelFormats")
+			Expect(formats).To(HaveLen(1))
+			Expect(formats[0].(map[string]interface{})["name"]).To(Equal("nvidia-nim-test"))
+			containers, _, _ := unstructured.NestedSlice(runtime.Object, "spec", "containers")
+			Expect(containers).To(HaveLen(1))
+			container := containers[0].(map[string]interface{})
+			Expect(container["name"]).To(Equal("kserve-container"))
+			Expect(container["image"]).To(Equal("nim-llm:latest"))
+			Expect(container["env"]).To(HaveLen(1))
+			volumes, _, _ := unstructured.NestedSlice(runtime.Object, "spec", "volumes")
+			Expect(volumes).To(HaveLen(1))
+			Expect(volumes[0].(map[string]interface{})["persistentVolumeClaim"].(map[string]interface{})["claimName"]).To(Equal("test-pvc"))
+		})
+
+		It("should render InferenceService template correctly", func() {
+			params := types.InferenceServiceParams{
+				Name:               "test-isvc",
+				Namespace:          "default",
+				RuntimeName:        "test-runtime",
+				ModelFormat:        "nvidia-nim-test",
+				MinReplicas:        1,
+				MaxReplicas:        3,
+				ScaleMetric:        "cpu",
+				ScaleTarget:        80,
+				ServiceAccountName: "test-sa",
+				Resources: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+				},
+			}
+			r := render.NewRenderer(templatesDir)
+			isvc, err := r.InferenceService(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isvc.GetKind()).To(Equal("InferenceService"))
+			Expect(isvc.GetName()).To(Equal("test-isvc"))
+			Expect(isvc.GetNamespace()).To(Equal("default"))
+			minReplicas, _, _ := unstructured.NestedInt64(isvc.Object, "spec", "predictor", "minReplicas")
+			Expect(minReplicas).To(Equal(int64(1)))
+			maxReplicas, _, _ := unstructured.NestedInt64(isvc.Object, "spec", "predictor", "maxReplicas")
+			Expect(maxReplicas).To(Equal(int64(3)))
+			scaleMetric, _, _ := unstructured.NestedString(isvc.Object, "spec", "predictor", "scaleMetric")
+			Expect(scaleMetric).To(Equal("cpu"))
+			runtimeName, _, _ := unstructured.NestedString(isvc.Object, "spec", "predictor", "model", "runtime")
+			Expect(runtimeName).To(Equal("test-runtime"))
+			modelFormat, _, _ := unstructured.NestedString(isvc.Object, "spec", "predictor", "model", "modelFormat", "name")
+			Expect(modelFormat).To(Equal("nvidia-nim-test"))
+			gpuLimit, _, _ := unstructured.NestedString(isvc.Object, "spec", "predictor", "model", "resources", "limits", "nvidia.com/gpu")
+			Expect(gpuLimit).To(Equal("1"))
+		})
+
+		It("should render LocalModelCache template correctly", func() {
+			params := types.LocalModelCacheParams{
+				Name:           "default-test-nimcache",
+				SourceModelURI: "pvc://test-pvc/models",
+				ModelSize:      "50Gi",
+				NodeGroups:     []string{"gpu-nodes"},
+			}
+			r := render.NewRenderer(templatesDir)
+			cache, err := r.LocalModelCache(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cache.GetKind()).To(Equal("LocalModelCache"))
+			Expect(cache.GetName()).To(Equal("default-test-nimcache"))
+			Expect(cache.GetNamespace()).To(BeEmpty())
+			sourceModelURI, _, _ := unstructured.NestedString(cache.Object, "spec", "sourceModelUri")
+			Expect(sourceModelURI).To(Equal("pvc://test-pvc/models"))
+			modelSize, _, _ := unstructured.NestedString(cache.Object, "spec", "modelSize")
+			Expect(modelSize).To(Equal("50Gi"))
+			nodeGroups, _, _ := unstructured.NestedStringSlice(cache.Object, "spec", "nodeGroups")
+			Expect(nodeGroups).To(Equal([]string{"gpu-nodes"}))
+		})
 	})
 })
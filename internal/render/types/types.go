@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DaemonsetParams holds the parameters for rendering a Daemonset template
@@ -71,7 +72,7 @@ type DeploymentParams struct {
 	Resources          *corev1.ResourceRequirements
 	NodeSelector       map[string]string
 	Tolerations        []corev1.Toleration
-	Affinity           *corev1.PodAffinity
+	Affinity           *corev1.Affinity
 	LivenessProbe      *corev1.Probe
 	ReadinessProbe     *corev1.Probe
 	StartupProbe       *corev1.Probe
@@ -81,37 +82,46 @@ type DeploymentParams struct {
 	GroupID            *int64
 	RuntimeClassName   string
 	OrchestratorType   string
+	ResourceClaims     []corev1.PodResourceClaim
+	// TopologySpreadConstraints describe how the pods are spread across nodes and zones
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint
 }
 
 // StatefulSetParams holds the parameters for rendering a StatefulSet template
 type StatefulSetParams struct {
-	Name               string
-	Namespace          string
-	Labels             map[string]string
-	Annotations        map[string]string
-	SelectorLabels     map[string]string
-	Replicas           int
-	ContainerName      string
-	ServiceName        string
-	Image              string
-	ImagePullSecrets   []string
-	ImagePullPolicy    string
-	Args               []string
-	Command            []string
-	Volumes            []corev1.Volume
-	VolumeMounts       []corev1.VolumeMount
-	Env                []corev1.EnvVar
-	Resources          *corev1.ResourceRequirements
-	NodeSelector       map[string]string
-	Tolerations        []corev1.Toleration
-	Affinity           *corev1.PodAffinity
-	ServiceAccountName string
-	LivenessProbe      *corev1.Probe
-	ReadinessProbe     *corev1.Probe
-	StartupProbe       *corev1.Probe
-	NIMCachePVC        string
-	RuntimeClassName   string
-	OrchestratorType   string
+	Name                 string
+	Namespace            string
+	Labels               map[string]string
+	Annotations          map[string]string
+	SelectorLabels       map[string]string
+	Replicas             int
+	ContainerName        string
+	ServiceName          string
+	Image                string
+	ImagePullSecrets     []string
+	ImagePullPolicy      string
+	Args                 []string
+	Command              []string
+	Volumes              []corev1.Volume
+	VolumeMounts         []corev1.VolumeMount
+	Env                  []corev1.EnvVar
+	Resources            *corev1.ResourceRequirements
+	NodeSelector         map[string]string
+	Tolerations          []corev1.Toleration
+	Affinity             *corev1.Affinity
+	ServiceAccountName   string
+	LivenessProbe        *corev1.Probe
+	ReadinessProbe       *corev1.Probe
+	StartupProbe         *corev1.Probe
+	NIMCachePVC          string
+	VolumeClaimTemplates []corev1.PersistentVolumeClaim
+	UserID               *int64
+	GroupID              *int64
+	RuntimeClassName     string
+	OrchestratorType     string
+	ResourceClaims       []corev1.PodResourceClaim
+	// TopologySpreadConstraints describe how the pods are spread across nodes and zones
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint
 }
 
 // ServiceParams holds the parameters for rendering a Service template
@@ -122,6 +132,7 @@ type ServiceParams struct {
 	TargetPort     int32
 	PortName       string
 	Protocol       string
+	GRPCPort       int32
 	Type           string
 	Labels         map[string]string
 	Annotations    map[string]string
@@ -190,6 +201,130 @@ type HPAParams struct {
 	HPASpec     autoscalingv2.HorizontalPodAutoscalerSpec
 }
 
+// PDBParams holds the parameters for rendering a PodDisruptionBudget template
+type PDBParams struct {
+	Enabled        bool
+	Name           string
+	Namespace      string
+	Labels         map[string]string
+	Annotations    map[string]string
+	SelectorLabels map[string]string
+	MinAvailable   *intstr.IntOrString
+	MaxUnavailable *intstr.IntOrString
+}
+
+// NetworkPolicyParams holds the parameters for rendering a NetworkPolicy template
+type NetworkPolicyParams struct {
+	Enabled        bool
+	Name           string
+	Namespace      string
+	Labels         map[string]string
+	Annotations    map[string]string
+	SelectorLabels map[string]string
+	Ingress        []networkingv1.NetworkPolicyIngressRule
+	Egress         []networkingv1.NetworkPolicyEgressRule
+}
+
+// GatewayRouteParams holds the parameters for rendering Gateway API HTTPRoute and GRPCRoute templates
+type GatewayRouteParams struct {
+	Enabled        bool
+	Name           string
+	Namespace      string
+	Labels         map[string]string
+	Annotations    map[string]string
+	ParentRefs     []GatewayParentRef
+	Hostnames      []string
+	Rules          []HTTPRouteRule
+	GRPCEnabled    bool
+	GRPCBackendRef GatewayBackendRef
+}
+
+// GatewayParentRef holds a Gateway the routes are attached to
+type GatewayParentRef struct {
+	Name        string `json:"name"`
+	Namespace   string `json:"namespace,omitempty"`
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// HTTPRouteRule holds the matches and the backends of an HTTPRoute rule
+type HTTPRouteRule struct {
+	Matches     []HTTPRouteMatch    `json:"matches,omitempty"`
+	BackendRefs []GatewayBackendRef `json:"backendRefs"`
+}
+
+// HTTPRouteMatch holds the conditions a request must meet to match an HTTPRoute rule
+type HTTPRouteMatch struct {
+	Path    *HTTPPathMatch    `json:"path,omitempty"`
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+	Method  string            `json:"method,omitempty"`
+}
+
+// HTTPPathMatch holds an HTTPRoute path match
+type HTTPPathMatch struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value"`
+}
+
+// HTTPHeaderMatch holds an HTTPRoute header match
+type HTTPHeaderMatch struct {
+	Type  string `json:"type,omitempty"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GatewayBackendRef holds a weighted backend Service of a route rule
+type GatewayBackendRef struct {
+	Name   string `json:"name"`
+	Port   int32  `json:"port"`
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// CertificateParams holds the parameters for rendering a cert-manager Certificate template
+type CertificateParams struct {
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+	SecretName  string
+	DNSNames    []string
+	IssuerName  string
+	IssuerKind  string
+	Duration    string
+	RenewBefore string
+}
+
+// ScaledObjectParams holds the parameters for rendering a KEDA ScaledObject template
+type ScaledObjectParams struct {
+	Name            string
+	Namespace       string
+	Labels          map[string]string
+	Annotations     map[string]string
+	ScaleTargetKind string
+	ScaleTargetAPI  string
+	MinReplicas     *int32
+	MaxReplicas     int32
+	PollingInterval *int32
+	CooldownPeriod  *int32
+	Triggers        []ScaledObjectTrigger
+	Behavior        *autoscalingv2.HorizontalPodAutoscalerBehavior
+}
+
+// ScaledObjectTrigger holds the parameters of a KEDA trigger
+type ScaledObjectTrigger struct {
+	Type       string
+	MetricType string
+	Metadata   map[string]string
+}
+
+// ConfigMapParams holds the parameters for rendering a ConfigMap template
+type ConfigMapParams struct {
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+	Data        map[string]string
+}
+
 // ServiceMonitorParams holds the parameters for rendering a ServiceMonitor template
 type ServiceMonitorParams struct {
 	Enabled       bool
@@ -204,3 +339,93 @@ type ServiceMonitorParams struct {
 	ScrapeTimeout int32
 	SMSpec        monitoringv1.ServiceMonitorSpec
 }
+
+// LeaderWorkerSetParams holds the parameters for rendering a LeaderWorkerSet template
+type LeaderWorkerSetParams struct {
+	Name               string
+	Namespace          string
+	Labels             map[string]string
+	Annotations        map[string]string
+	SelectorLabels     map[string]string
+	Replicas           int
+	Size               int32
+	ContainerName      string
+	Args               []string
+	Command            []string
+	Image              string
+	ImagePullSecrets   []string
+	ImagePullPolicy    string
+	Port               int32
+	Volumes            []corev1.Volume
+	VolumeMounts       []corev1.VolumeMount
+	LeaderEnv          []corev1.EnvVar
+	WorkerEnv          []corev1.EnvVar
+	Resources          *corev1.ResourceRequirements
+	NodeSelector       map[string]string
+	Tolerations        []corev1.Toleration
+	Affinity           *corev1.Affinity
+	LivenessProbe      *corev1.Probe
+	ReadinessProbe     *corev1.Probe
+	StartupProbe       *corev1.Probe
+	ServiceAccountName string
+	UserID             *int64
+	GroupID            *int64
+	RuntimeClassName   string
+	OrchestratorType   string
+	// TopologySpreadConstraints describe how the pods are spread across nodes and zones
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint
+}
+
+// ServingRuntimeParams holds the parameters for rendering a KServe ServingRuntime template
+type ServingRuntimeParams struct {
+	Name             string
+	Namespace        string
+	Labels           map[string]string
+	Annotations      map[string]string
+	ModelFormat      string
+	ContainerName    string
+	Image            string
+	ImagePullSecrets []string
+	ImagePullPolicy  string
+	Args             []string
+	Command          []string
+	Port             int32
+	Volumes          []corev1.Volume
+	VolumeMounts     []corev1.VolumeMount
+	Env              []corev1.EnvVar
+	NodeSelector     map[string]string
+	Tolerations      []corev1.Toleration
+	LivenessProbe    *corev1.Probe
+	ReadinessProbe   *corev1.Probe
+	StartupProbe     *corev1.Probe
+}
+
+// InferenceServiceParams holds the parameters for rendering a KServe InferenceService template
+type InferenceServiceParams struct {
+	Name               string
+	Namespace          string
+	Labels             map[string]string
+	Annotations        map[string]string
+	RuntimeName        string
+	ModelFormat        string
+	MinReplicas        int32
+	MaxReplicas        int32
+	ScaleMetric        string
+	ScaleTarget        int32
+	Resources          *corev1.ResourceRequirements
+	ServiceAccountName string
+	RuntimeClassName   string
+	UserID             *int64
+	GroupID            *int64
+	OrchestratorType   string
+}
+
+// LocalModelCacheParams holds the parameters for rendering a KServe LocalModelCache template
+type LocalModelCacheParams struct {
+	Name           string
+	Labels         map[string]string
+	Annotations    map[string]string
+	SourceModelURI string
+	ModelSize      string
+	NodeGroups     []string
+}
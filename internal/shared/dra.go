@@ -0,0 +1,119 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// DefaultDRADeviceClassName is the DeviceClass of the GPUs published by the NVIDIA DRA driver
+	DefaultDRADeviceClassName = "gpu.nvidia.com"
+	// DRAAttributeDomain is the domain of the device attributes published by the NVIDIA DRA driver
+	DRAAttributeDomain = "gpu.nvidia.com"
+)
+
+// ConstructResourceClaimTemplate constructs a ResourceClaimTemplate requesting the given number of GPUs
+// with an optional product name selector
+func ConstructResourceClaimTemplate(dra appsv1alpha1.DRAResources, count int64, productName string, templateMeta metav1.ObjectMeta) *resourcev1alpha3.ResourceClaimTemplate {
+	deviceClassName := dra.DeviceClassName
+	if deviceClassName == "" {
+		deviceClassName = DefaultDRADeviceClassName
+	}
+	if dra.Count != nil {
+		count = *dra.Count
+	}
+	if count < 1 {
+		count = 1
+	}
+	if dra.ProductName != "" {
+		productName = dra.ProductName
+	}
+
+	request := resourcev1alpha3.DeviceRequest{
+		Name:            appsv1alpha1.DRAClaimName,
+		DeviceClassName: deviceClassName,
+		AllocationMode:  resourcev1alpha3.DeviceAllocationModeExactCount,
+		Count:           count,
+	}
+	if productName != "" {
+		request.Selectors = []resourcev1alpha3.DeviceSelector{
+			{
+				CEL: &resourcev1alpha3.CELDeviceSelector{
+					Expression: fmt.Sprintf("device.attributes[%q].productName.matches(%q)", DRAAttributeDomain, productNameRegexp(productName)),
+				},
+			},
+		}
+	}
+
+	return &resourcev1alpha3.ResourceClaimTemplate{
+		ObjectMeta: templateMeta,
+		Spec: resourcev1alpha3.ResourceClaimTemplateSpec{
+			Spec: resourcev1alpha3.ResourceClaimSpec{
+				Devices: resourcev1alpha3.DeviceClaim{
+					Requests: []resourcev1alpha3.DeviceRequest{request},
+				},
+			},
+		},
+	}
+}
+
+// GPUNameTokens splits a GPU name into upper case tokens on the characters other than letters and digits
+func GPUNameTokens(name string) []string {
+	return strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// productNameRegexp returns the case insensitive expression matching the product names with the tokens of the
+// GPU name in sequence, so that "H100" matches "NVIDIA H100 80GB HBM3" while "A10" does not match "NVIDIA A100"
+func productNameRegexp(productName string) string {
+	tokens := GPUNameTokens(productName)
+	for i, token := range tokens {
+		tokens[i] = regexp.QuoteMeta(token)
+	}
+	return "(?i)(^|[^[:alnum:]])" + strings.Join(tokens, "[^[:alnum:]]+") + "([^[:alnum:]]|$)"
+}
+
+// ResourceClaimsForTemplate returns the pod resource claims referencing the given ResourceClaimTemplate
+func ResourceClaimsForTemplate(templateName string) []corev1.PodResourceClaim {
+	return []corev1.PodResourceClaim{
+		{
+			Name:                      appsv1alpha1.DRAClaimName,
+			ResourceClaimTemplateName: ptr.To(templateName),
+		},
+	}
+}
+
+// AddResourceClaim adds the GPU resource claim to the given container resources
+func AddResourceClaim(resources *corev1.ResourceRequirements) {
+	for _, claim := range resources.Claims {
+		if claim.Name == appsv1alpha1.DRAClaimName {
+			return
+		}
+	}
+	resources.Claims = append(resources.Claims, corev1.ResourceClaim{Name: appsv1alpha1.DRAClaimName})
+}
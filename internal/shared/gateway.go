@@ -0,0 +1,173 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HTTPRouteGVK is the GroupVersionKind of the Gateway API HTTPRoute
+var HTTPRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}
+
+// GRPCRouteGVK is the GroupVersionKind of the Gateway API GRPCRoute
+var GRPCRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "GRPCRoute"}
+
+// GatewayRouteRequeueInterval is the interval to poll the routes until they are accepted by their Gateways
+const GatewayRouteRequeueInterval = 30 * time.Second
+
+// gatewayRouteConditions maps the route parent conditions to the conditions reported on the owning resource
+var gatewayRouteConditions = map[string]string{
+	"Accepted":     conditions.GatewayRouteAccepted,
+	"ResolvedRefs": conditions.GatewayRouteResolvedRefs,
+}
+
+// GatewayRouteSyncFunc renders and syncs a route owned by the resource, reporting the failures in its conditions
+type GatewayRouteSyncFunc func(obj client.Object, renderFunc func() (client.Object, error), conditionType string) error
+
+// ReconcileGatewayRoutes syncs the Gateway API routes of a resource and reports their status in its conditions,
+// returns true once the routes are accepted by their Gateways
+func ReconcileGatewayRoutes(ctx context.Context, c client.Client, renderer render.Renderer, params *rendertypes.GatewayRouteParams, statusConditions *[]metav1.Condition, sync GatewayRouteSyncFunc) (bool, error) {
+	namespacedName := types.NamespacedName{Name: params.Name, Namespace: params.Namespace}
+
+	err := sync(NewUnstructured(HTTPRouteGVK), func() (client.Object, error) {
+		return renderer.HTTPRoute(params)
+	}, "httproute")
+	if err != nil {
+		return false, err
+	}
+
+	if params.GRPCEnabled {
+		err = sync(NewUnstructured(GRPCRouteGVK), func() (client.Object, error) {
+			return renderer.GRPCRoute(params)
+		}, "grpcroute")
+	} else {
+		err = cleanupGatewayRoute(ctx, c, GRPCRouteGVK, namespacedName)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var routes []*unstructured.Unstructured
+	for _, gvk := range []schema.GroupVersionKind{HTTPRouteGVK, GRPCRouteGVK} {
+		if gvk == GRPCRouteGVK && !params.GRPCEnabled {
+			continue
+		}
+		route := NewUnstructured(gvk)
+		if err := c.Get(ctx, namespacedName, route); err != nil {
+			return false, err
+		}
+		routes = append(routes, route)
+	}
+	return SetGatewayRouteConditions(statusConditions, routes...), nil
+}
+
+// CleanupGatewayRoutes deletes the Gateway API routes of a resource and their conditions once the routes are disabled
+func CleanupGatewayRoutes(ctx context.Context, c client.Client, namespacedName types.NamespacedName, statusConditions *[]metav1.Condition) error {
+	for _, gvk := range []schema.GroupVersionKind{HTTPRouteGVK, GRPCRouteGVK} {
+		if err := cleanupGatewayRoute(ctx, c, gvk, namespacedName); err != nil {
+			return err
+		}
+	}
+	RemoveGatewayRouteConditions(statusConditions)
+	return nil
+}
+
+// cleanupGatewayRoute deletes a Gateway API route if it exists, ignoring clusters without the Gateway API CRDs
+func cleanupGatewayRoute(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, namespacedName types.NamespacedName) error {
+	route := NewUnstructured(gvk)
+	route.SetName(namespacedName.Name)
+	route.SetNamespace(namespacedName.Namespace)
+	err := c.Delete(ctx, route)
+	if err != nil && client.IgnoreNotFound(err) != nil && !meta.IsNoMatchError(err) {
+		return err
+	}
+	return nil
+}
+
+// NewUnstructured returns an empty unstructured object of the given kind
+func NewUnstructured(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+// SetGatewayRouteConditions reports the Accepted and ResolvedRefs conditions of the route parents,
+// returns true once every Gateway accepted the routes and resolved their backends
+func SetGatewayRouteConditions(statusConditions *[]metav1.Condition, routes ...*unstructured.Unstructured) bool {
+	ready := true
+	for _, parentType := range []string{"Accepted", "ResolvedRefs"} {
+		condition := metav1.Condition{
+			Type:    gatewayRouteConditions[parentType],
+			Status:  metav1.ConditionUnknown,
+			Reason:  conditions.ReasonGatewayRoutePending,
+			Message: "Waiting for the gateway to report the route status",
+		}
+
+		for _, route := range routes {
+			parents, _, _ := unstructured.NestedSlice(route.Object, "status", "parents")
+			for _, parent := range parents {
+				parentMap, ok := parent.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				parentConditions, _, _ := unstructured.NestedSlice(parentMap, "conditions")
+				for _, c := range parentConditions {
+					parentCondition, ok := c.(map[string]interface{})
+					if !ok || parentCondition["type"] != parentType {
+						continue
+					}
+					if parentCondition["status"] != string(metav1.ConditionTrue) {
+						reason, _ := parentCondition["reason"].(string)
+						message, _ := parentCondition["message"].(string)
+						condition.Status = metav1.ConditionFalse
+						condition.Reason = reason
+						condition.Message = fmt.Sprintf("%s %s: %s", route.GetKind(), route.GetName(), message)
+					} else if condition.Status == metav1.ConditionUnknown {
+						condition.Status = metav1.ConditionTrue
+						condition.Reason = parentType
+						condition.Message = ""
+					}
+				}
+			}
+		}
+
+		if condition.Status != metav1.ConditionTrue {
+			ready = false
+		}
+		meta.SetStatusCondition(statusConditions, condition)
+	}
+	return ready
+}
+
+// RemoveGatewayRouteConditions removes the route conditions once the routes are disabled
+func RemoveGatewayRouteConditions(statusConditions *[]metav1.Condition) {
+	for _, conditionType := range gatewayRouteConditions {
+		meta.RemoveStatusCondition(statusConditions, conditionType)
+	}
+}
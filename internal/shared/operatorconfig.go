@@ -0,0 +1,74 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// GetOperatorConfig returns the NIMOperatorConfig with the operator defaults, nil when it does not exist
+func GetOperatorConfig(ctx context.Context, c client.Client) (*appsv1alpha1.NIMOperatorConfig, error) {
+	config := &appsv1alpha1.NIMOperatorConfig{}
+	err := c.Get(ctx, types.NamespacedName{Name: appsv1alpha1.NIMOperatorConfigName}, config)
+	if err != nil {
+		if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return config, nil
+}
+
+// EnqueueRequestsForOperatorConfig requeues every object of the given list kind when the NIMOperatorConfig changes
+func EnqueueRequestsForOperatorConfig(c client.Client, list client.ObjectList) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		logger := log.FromContext(ctx)
+		if obj.GetName() != appsv1alpha1.NIMOperatorConfigName {
+			return nil
+		}
+
+		objList := list.DeepCopyObject().(client.ObjectList)
+		if err := c.List(ctx, objList); err != nil {
+			logger.Error(err, "unable to list objects to apply the operator config")
+			return nil
+		}
+		items, err := meta.ExtractList(objList)
+		if err != nil {
+			logger.Error(err, "unable to extract objects to apply the operator config")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, item := range items {
+			if o, ok := item.(client.Object); ok {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: o.GetName(), Namespace: o.GetNamespace()},
+				})
+			}
+		}
+		return requests
+	})
+}
@@ -18,14 +18,18 @@ package utils
 
 import (
 	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
+	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
@@ -36,6 +40,10 @@ import (
 const (
 	// NvidiaAnnotationHashKey indicates annotation name for last applied hash by the operator
 	NvidiaAnnotationHashKey = "nvidia.com/last-applied-hash"
+	// HTTPResponseHeaderTimeout is the time to wait for the response headers of the HTTP requests to model endpoints
+	HTTPResponseHeaderTimeout = time.Minute
+	// TLSCAKey is the key of the CA certificate in the serving certificate secrets issued by cert-manager
+	TLSCAKey = "ca.crt"
 )
 
 // GetFilesWithSuffix returns all files under a given base directory that have a specific suffix
@@ -279,3 +287,37 @@ func SortHPAMetricsSpec(metrics []autoscalingv2.MetricSpec) []autoscalingv2.Metr
 	})
 	return metrics
 }
+
+// NewHTTPClient returns an HTTP client timing out on connections and response headers, and on the whole request when
+// the timeout is set, left unset to download large files. The given CAs are trusted instead of the system ones when set
+func NewHTTPClient(timeout time.Duration, rootCAs *x509.CertPool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = HTTPResponseHeaderTimeout
+	if rootCAs != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Transport: transport, Timeout: timeout}
+}
+
+// NewCertPool returns the system CAs with the given PEM encoded certificates
+func NewCertPool(certificates ...string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	for _, certificate := range certificates {
+		if !pool.AppendCertsFromPEM([]byte(certificate)) {
+			return nil, fmt.Errorf("no valid PEM encoded certificate found")
+		}
+	}
+	return pool, nil
+}
+
+// GetServingCA returns the CA certificate of a serving certificate secret, the certificate itself when the secret has
+// no CA as for self-signed certificates
+func GetServingCA(data map[string][]byte) []byte {
+	if ca := data[TLSCAKey]; len(ca) > 0 {
+		return ca
+	}
+	return data[corev1.TLSCertKey]
+}
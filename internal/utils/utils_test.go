@@ -482,3 +482,12 @@ func TestContainsElement(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCertPool(t *testing.T) {
+	_, err := NewCertPool("not a certificate")
+	assert.ErrorContains(t, err, "no valid PEM encoded certificate")
+
+	pool, err := NewCertPool()
+	assert.NoError(t, err)
+	assert.NotNil(t, pool)
+}